@@ -0,0 +1,123 @@
+package date
+
+import "time"
+
+// CalendarLayouts holds the layouts of the calendar time for each bucket.
+type CalendarLayouts struct {
+	// SameDay is the layout of the time in the same day as the reference time.
+	SameDay string
+	// NextDay is the layout of the time in the next day of the reference time.
+	NextDay string
+	// NextWeek is the layout of the time within the next 7 days of the reference time.
+	NextWeek string
+	// LastDay is the layout of the time in the previous day of the reference time.
+	LastDay string
+	// LastWeek is the layout of the time within the last 7 days of the reference time.
+	LastWeek string
+	// SameElse is the layout of the other times.
+	SameElse string
+}
+
+// englishCalendarLayouts is the layouts of the calendar time in English.
+var englishCalendarLayouts = CalendarLayouts{
+	SameDay:  "[Today at] h:mm A",
+	NextDay:  "[Tomorrow at] h:mm A",
+	NextWeek: "dddd [at] h:mm A",
+	LastDay:  "[Yesterday at] h:mm A",
+	LastWeek: "[Last] dddd [at] h:mm A",
+	SameElse: "MM/DD/YYYY",
+}
+
+// calendarOptions is the options of formatting the calendar time.
+type calendarOptions struct {
+	locale  *Locale
+	layouts CalendarLayouts
+}
+
+// CalendarOption is the option of formatting the calendar time.
+type CalendarOption func(*calendarOptions)
+
+// CalendarLocale sets the locale of the calendar time, it uses the calendar layouts of the locale
+// if they're not set by the CalendarLayout option.
+func CalendarLocale(locale *Locale) CalendarOption {
+	return func(opts *calendarOptions) {
+		opts.locale = getLocale(locale)
+	}
+}
+
+// CalendarLayout sets the layouts of the calendar time, the empty layouts are ignored.
+func CalendarLayout(layouts CalendarLayouts) CalendarOption {
+	return func(opts *calendarOptions) {
+		mergeCalendarLayouts(&opts.layouts, layouts)
+	}
+}
+
+// Calendar returns the calendar time relative to the reference time, for example
+// "Today at 2:30 PM", "Yesterday at 9:00 AM", or "Last Monday at 9:00 AM". It chooses the layout
+// by comparing the calendar days of the time and the reference time in the location of the time.
+// The parameter ref must be a Time or time.Time, or it'll panic.
+func (t Time) Calendar(ref any, opts ...CalendarOption) string {
+	refTm := getTime(ref).In(t.Location())
+
+	options := calendarOptions{locale: English}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	layouts := options.layouts
+	mergeCalendarLayouts(&layouts, options.locale.Calendar)
+	mergeCalendarLayouts(&layouts, englishCalendarLayouts)
+
+	var layout string
+	switch diff := daysBetween(refTm, t.Time); {
+	case diff < -6:
+		layout = layouts.SameElse
+	case diff < -1:
+		layout = layouts.LastWeek
+	case diff < 0:
+		layout = layouts.LastDay
+	case diff < 1:
+		layout = layouts.SameDay
+	case diff < 2:
+		layout = layouts.NextDay
+	case diff < 7:
+		layout = layouts.NextWeek
+	default:
+		layout = layouts.SameElse
+	}
+
+	return t.FormatLocale(layout, options.locale)
+}
+
+// mergeCalendarLayouts sets the empty layouts of dst by the layouts of src.
+func mergeCalendarLayouts(dst *CalendarLayouts, src CalendarLayouts) {
+	if dst.SameDay == "" {
+		dst.SameDay = src.SameDay
+	}
+	if dst.NextDay == "" {
+		dst.NextDay = src.NextDay
+	}
+	if dst.NextWeek == "" {
+		dst.NextWeek = src.NextWeek
+	}
+	if dst.LastDay == "" {
+		dst.LastDay = src.LastDay
+	}
+	if dst.LastWeek == "" {
+		dst.LastWeek = src.LastWeek
+	}
+	if dst.SameElse == "" {
+		dst.SameElse = src.SameElse
+	}
+}
+
+// daysBetween returns the number of the calendar days from the date of t to the date of u, in
+// their own locations.
+func daysBetween(t, u time.Time) int {
+	y1, m1, d1 := t.Date()
+	y2, m2, d2 := u.Date()
+
+	start := time.Date(y1, m1, d1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(y2, m2, d2, 0, 0, 0, 0, time.UTC)
+
+	return int((end.Unix() - start.Unix()) / 86400)
+}
@@ -0,0 +1,87 @@
+package date_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ghosind/go-assert"
+	"github.com/ghosind/go-date"
+)
+
+func TestCalendar(t *testing.T) {
+	a := assert.New(t)
+
+	// Wednesday
+	ref := date.Date(2024, time.January, 10, 12, 0, 0, 0)
+	cases := []struct {
+		tm     date.Time
+		expect string
+	}{
+		{date.Date(2024, time.January, 10, 14, 30, 0, 0), "Today at 2:30 PM"},
+		{date.Date(2024, time.January, 10, 0, 0, 0, 0), "Today at 12:00 AM"},
+		{date.Date(2024, time.January, 11, 9, 0, 0, 0), "Tomorrow at 9:00 AM"},
+		{date.Date(2024, time.January, 9, 23, 59, 0, 0), "Yesterday at 11:59 PM"},
+		{date.Date(2024, time.January, 12, 9, 0, 0, 0), "Friday at 9:00 AM"},
+		{date.Date(2024, time.January, 16, 9, 0, 0, 0), "Tuesday at 9:00 AM"},
+		{date.Date(2024, time.January, 17, 9, 0, 0, 0), "01/17/2024"},
+		{date.Date(2024, time.January, 8, 9, 0, 0, 0), "Last Monday at 9:00 AM"},
+		{date.Date(2024, time.January, 4, 9, 0, 0, 0), "Last Thursday at 9:00 AM"},
+		{date.Date(2024, time.January, 3, 9, 0, 0, 0), "01/03/2024"},
+		{date.Date(2023, time.January, 10, 12, 0, 0, 0), "01/10/2023"},
+	}
+
+	for _, test := range cases {
+		a.EqualNow(test.tm.Calendar(ref), test.expect)
+		a.EqualNow(test.tm.Calendar(ref.Time), test.expect)
+	}
+
+	a.PanicOfNow(func() { ref.Calendar(1) }, date.ErrNotTime)
+}
+
+func TestCalendarInLocation(t *testing.T) {
+	a := assert.New(t)
+
+	tzLA, _ := time.LoadLocation("America/Los_Angeles")
+	tzSH, _ := time.LoadLocation("Asia/Shanghai")
+
+	// 2024-03-10 is the day of the DST transition in Los Angeles, the day has 23 hours.
+	ref := date.Date(2024, time.March, 10, 23, 0, 0, 0, tzLA)
+	a.EqualNow(date.Date(2024, time.March, 10, 0, 30, 0, 0, tzLA).Calendar(ref), "Today at 12:30 AM")
+	a.EqualNow(date.Date(2024, time.March, 9, 23, 30, 0, 0, tzLA).Calendar(ref),
+		"Yesterday at 11:30 PM")
+	a.EqualNow(date.Date(2024, time.March, 11, 0, 0, 0, 0, tzLA).Calendar(ref),
+		"Tomorrow at 12:00 AM")
+
+	// the reference time is converted to the location of the time
+	ref = date.Date(2024, time.January, 10, 20, 0, 0, 0, time.UTC) // 2024-01-11 04:00 in Shanghai
+	a.EqualNow(date.Date(2024, time.January, 11, 9, 0, 0, 0, tzSH).Calendar(ref), "Today at 9:00 AM")
+}
+
+func TestCalendarOptions(t *testing.T) {
+	a := assert.New(t)
+
+	ref := date.Date(2024, time.January, 10, 12, 0, 0, 0)
+	tm := date.Date(2024, time.January, 10, 14, 30, 0, 0)
+
+	a.EqualNow(tm.Calendar(ref, date.CalendarLayout(date.CalendarLayouts{
+		SameDay: "HH:mm",
+	})), "14:30")
+	a.EqualNow(date.Date(2024, time.January, 1, 0, 0, 0, 0).Calendar(ref, date.CalendarLayout(
+		date.CalendarLayouts{SameDay: "HH:mm"},
+	)), "01/01/2024")
+
+	french := &date.Locale{
+		Weekdays:       []string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		Meridiems:      [2]string{"AM", "PM"},
+		LowerMeridiems: [2]string{"am", "pm"},
+		Calendar: date.CalendarLayouts{
+			SameDay:  "\\A\\u\\j\\o\\u\\r\\d'\\h\\u\\i \\à HH:mm",
+			NextWeek: "dddd \\à HH:mm",
+		},
+	}
+	a.EqualNow(tm.Calendar(ref, date.CalendarLocale(french)), "Aujourd'hui à 14:30")
+	a.EqualNow(
+		date.Date(2024, time.January, 12, 9, 0, 0, 0).Calendar(ref, date.CalendarLocale(french)),
+		"vendredi à 09:00",
+	)
+}
@@ -0,0 +1,279 @@
+package date
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+)
+
+// secondsPerDay is the number of the seconds in a day without the time zone transitions.
+const secondsPerDay = 24 * 60 * 60
+
+// civilDateLayout is the layout of the string form and the encodings of the civil date.
+const civilDateLayout = "YYYY-MM-DD"
+
+// civilLayoutCache is the LRU cache of the compiled layouts of the civil dates.
+var civilLayoutCache = newLayoutCache(layoutCacheSize, compileCivilLayout)
+
+// CivilDate is a date without the time of day and the location, for example a birthday or a
+// holiday. It's not a time instant, so it does not change when moving between the time zones.
+type CivilDate struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// NewCivilDate returns the civil date of the year, the month, and the day. The values are
+// normalized like time.Date, for example October 32 is converted to November 1.
+func NewCivilDate(year int, month time.Month, day int) CivilDate {
+	y, m, d := time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Date()
+	return CivilDate{Year: y, Month: m, Day: d}
+}
+
+// CivilDateOf returns the civil date of the time in the location of the time.
+func CivilDateOf(t Time) CivilDate {
+	y, m, d := t.Date()
+	return CivilDate{Year: y, Month: m, Day: d}
+}
+
+// Today returns the civil date of today in the location, default time.Local.
+func Today(loc ...*time.Location) CivilDate {
+	location := time.Local
+	if len(loc) > 0 && loc[0] != nil {
+		location = loc[0]
+	}
+
+	return CivilDateOf(New(time.Now().In(location)))
+}
+
+// ParseCivilDate parses a formatted string with the layout and returns the civil date it
+// represents. The layout supports the date tokens only, and the other tokens like "HH" are the
+// literal text. It's strict like ParseStrict, so the invalid dates like "2024-02-30" are rejected.
+func ParseCivilDate(layout, value string) (CivilDate, error) {
+	tm, err := civilLayoutCache.get(layout).parse(value, parseOptions{
+		loc:    time.UTC,
+		locale: English,
+		strict: true,
+	})
+	if err != nil {
+		return CivilDate{}, err
+	}
+
+	return CivilDateOf(tm), nil
+}
+
+// compileCivilLayout compiles the layout, and converts the tokens that are not the date tokens to
+// the literal text.
+func compileCivilLayout(layout string) *Layout {
+	l := compileLayout(layout)
+
+	for i, tok := range l.tokens {
+		if !isDateToken(tok.kind) {
+			l.tokens[i] = layoutToken{kind: layoutTokenNone, value: tok.value}
+		}
+	}
+
+	return l
+}
+
+// isDateToken reports whether the token is a part of the date, it's not a part of the time of day
+// or the time zone.
+func isDateToken(token int) bool {
+	switch token {
+	case layoutTokenYearLong, layoutTokenYear, layoutTokenCentury,
+		layoutTokenMonth, layoutTokenMonthLong, layoutTokenMonthAbbr, layoutTokenMonthFull,
+		layoutTokenMonthOrdinal,
+		layoutTokenDay, layoutTokenDayLong, layoutTokenDaySpace, layoutTokenDayOrdinal,
+		layoutTokenDayOfWeek, layoutTokenDayOfWeekAbbr, layoutTokenDayOfWeekFull,
+		layoutTokenDayOfWeekOrdinal,
+		layoutTokenDayOfYear, layoutTokenDayOfYearLong, layoutTokenDayOfYearSpace,
+		layoutTokenISOWeek, layoutTokenISOWeekLong, layoutTokenISOWeekYear,
+		layoutTokenISOWeekYearLong, layoutTokenISOWeekday,
+		layoutTokenWeekSunday, layoutTokenWeekMonday,
+		layoutTokenQuarter, layoutTokenQuarterOrdinal:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the date in the form of "YYYY-MM-DD", or an empty string for the zero date.
+func (d CivilDate) String() string {
+	if d.IsZero() {
+		return ""
+	}
+	return d.Format(civilDateLayout)
+}
+
+// Format returns a string of the date formatted by the layout. The layout supports the date tokens
+// only, and the other tokens like "HH" are copied to the result as they are.
+func (d CivilDate) Format(layout string) string {
+	buf := make([]byte, 0, 32)
+	buf = civilLayoutCache.get(layout).appendFormat(buf, d.In(time.UTC), English)
+
+	return string(buf)
+}
+
+// IsZero reports whether the date is the zero value.
+func (d CivilDate) IsZero() bool {
+	return d == CivilDate{}
+}
+
+// IsValid reports whether the date is a valid date, for example February 30 is not valid.
+func (d CivilDate) IsValid() bool {
+	return d == NewCivilDate(d.Year, d.Month, d.Day)
+}
+
+// In returns the time of the start of the date in the location.
+func (d CivilDate) In(loc *time.Location) Time {
+	return Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
+
+// Weekday returns the day of week of the date.
+func (d CivilDate) Weekday() time.Weekday {
+	return d.In(time.UTC).Weekday()
+}
+
+// YearDay returns the day of year of the date, in the range [1, 365] for non-leap years, and
+// [1, 366] in leap years.
+func (d CivilDate) YearDay() int {
+	return d.In(time.UTC).YearDay()
+}
+
+// AddDays returns the date that the number of the days after the date.
+func (d CivilDate) AddDays(days int) CivilDate {
+	return NewCivilDate(d.Year, d.Month, d.Day+days)
+}
+
+// AddMonths returns the date that the number of the months after the date. The day is clamped to
+// the last day of the month, for example one month after January 31 is the last day of February.
+func (d CivilDate) AddMonths(months int) CivilDate {
+	y, m, day := addMonths(d.Year, d.Month, d.Day, months)
+	return CivilDate{Year: y, Month: m, Day: day}
+}
+
+// AddYears returns the date that the number of the years after the date. The day is clamped to the
+// last day of the month, for example one year after February 29 is February 28.
+func (d CivilDate) AddYears(years int) CivilDate {
+	return d.AddMonths(years * 12)
+}
+
+// DaysSince returns the number of the days from the other date to the date, it's negative if the
+// date is before the other date.
+func (d CivilDate) DaysSince(other CivilDate) int {
+	return int((d.In(time.UTC).Unix() - other.In(time.UTC).Unix()) / secondsPerDay)
+}
+
+// Compare compares the dates, it returns -1 if the date is before the other date, 1 if it's after
+// the other date, and 0 if they're the same date.
+func (d CivilDate) Compare(other CivilDate) int {
+	switch {
+	case d.Year != other.Year:
+		return compareInt(d.Year, other.Year)
+	case d.Month != other.Month:
+		return compareInt(int(d.Month), int(other.Month))
+	default:
+		return compareInt(d.Day, other.Day)
+	}
+}
+
+// Before reports whether the date is before the other date.
+func (d CivilDate) Before(other CivilDate) bool {
+	return d.Compare(other) < 0
+}
+
+// After reports whether the date is after the other date.
+func (d CivilDate) After(other CivilDate) bool {
+	return d.Compare(other) > 0
+}
+
+// MarshalJSON implements the json.Marshaler interface, the date is a quoted string in the form of
+// "YYYY-MM-DD", and the zero date is an empty string.
+func (d CivilDate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, the date is a quoted string in the
+// form of "YYYY-MM-DD". A null value is a no-op, and an empty string is the zero date.
+func (d *CivilDate) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+
+	return d.UnmarshalText([]byte(str))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface, the date is in the form of
+// "YYYY-MM-DD", and the zero date is an empty text.
+func (d CivilDate) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface, the date is in the form of
+// "YYYY-MM-DD". An empty text is the zero date.
+func (d *CivilDate) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*d = CivilDate{}
+		return nil
+	}
+
+	parsed, err := ParseCivilDate(civilDateLayout, string(data))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+
+	return nil
+}
+
+// Scan implements the sql.Scanner interface. It accepts time.Time that the date is in the location
+// of the time, and the strings and the bytes in the scan layouts (see SetScanLayouts) that the date
+// is in the time zone of the value. A nil value is the zero date.
+func (d *CivilDate) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*d = CivilDate{}
+	case time.Time:
+		*d = CivilDateOf(New(v))
+	case Time:
+		*d = CivilDateOf(v)
+	case string:
+		return d.scanText(v)
+	case []byte:
+		return d.scanText(string(v))
+	default:
+		return ErrUnsupportedType
+	}
+
+	return nil
+}
+
+// scanText scans the date from the string in the scan layouts, an empty string is the zero date.
+func (d *CivilDate) scanText(str string) error {
+	if str == "" {
+		*d = CivilDate{}
+		return nil
+	}
+
+	var tm Time
+	if err := tm.Scan(str); err != nil {
+		return err
+	}
+	*d = CivilDateOf(tm)
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface, the value is a string in the form of
+// "YYYY-MM-DD", and the zero date is NULL.
+func (d CivilDate) Value() (driver.Value, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+	return d.String(), nil
+}
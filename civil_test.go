@@ -0,0 +1,172 @@
+package date_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ghosind/go-assert"
+	"github.com/ghosind/go-date"
+)
+
+func TestCivilDate(t *testing.T) {
+	a := assert.New(t)
+
+	d := date.NewCivilDate(2024, time.January, 31)
+	a.EqualNow(d, date.CivilDate{Year: 2024, Month: time.January, Day: 31})
+	a.EqualNow(date.NewCivilDate(2024, time.October, 32), date.NewCivilDate(2024, time.November, 1))
+	a.TrueNow(d.IsValid())
+	a.NotTrueNow(date.CivilDate{Year: 2023, Month: time.February, Day: 29}.IsValid())
+	a.NotTrueNow(d.IsZero())
+	a.TrueNow(date.CivilDate{}.IsZero())
+
+	a.EqualNow(d.Weekday(), time.Wednesday)
+	a.EqualNow(d.YearDay(), 31)
+	a.EqualNow(d.String(), "2024-01-31")
+
+	// the date of the time in its location
+	loc := time.FixedZone("UTC-10", -10*60*60)
+	tm := date.Date(2024, time.February, 1, 3, 0, 0, 0, time.UTC).In(loc)
+	a.EqualNow(date.CivilDateOf(tm), d)
+
+	in := d.In(loc)
+	a.TrueNow(in.Equal(date.Date(2024, time.January, 31, 0, 0, 0, 0, loc)))
+	a.EqualNow(in.Location(), loc)
+
+	a.EqualNow(date.Today(time.UTC), date.CivilDateOf(date.Now().In(time.UTC)))
+}
+
+func TestCivilDateArithmetic(t *testing.T) {
+	a := assert.New(t)
+
+	d := date.NewCivilDate(2024, time.January, 31)
+	a.EqualNow(d.AddDays(1), date.NewCivilDate(2024, time.February, 1))
+	a.EqualNow(d.AddDays(-31), date.NewCivilDate(2023, time.December, 31))
+	a.EqualNow(d.AddMonths(1), date.NewCivilDate(2024, time.February, 29))
+	a.EqualNow(d.AddMonths(-2), date.NewCivilDate(2023, time.November, 30))
+	a.EqualNow(d.AddMonths(13), date.NewCivilDate(2025, time.February, 28))
+	a.EqualNow(date.NewCivilDate(2024, time.February, 29).AddYears(1),
+		date.NewCivilDate(2025, time.February, 28))
+
+	a.EqualNow(d.DaysSince(date.NewCivilDate(2024, time.January, 1)), 30)
+	a.EqualNow(d.DaysSince(date.NewCivilDate(2024, time.March, 1)), -30)
+	first := date.NewCivilDate(1, time.January, 1)
+	a.EqualNow(date.NewCivilDate(2400, time.January, 1).DaysSince(first), 876216)
+
+	a.EqualNow(d.Compare(d), 0)
+	a.EqualNow(d.Compare(date.NewCivilDate(2024, time.February, 1)), -1)
+	a.EqualNow(d.Compare(date.NewCivilDate(2023, time.December, 31)), 1)
+	a.TrueNow(d.Before(d.AddDays(1)))
+	a.NotTrueNow(d.Before(d))
+	a.TrueNow(d.After(d.AddMonths(-1)))
+	a.NotTrueNow(d.After(d))
+}
+
+func TestCivilDateFormat(t *testing.T) {
+	a := assert.New(t)
+
+	d := date.NewCivilDate(2024, time.January, 10)
+	a.EqualNow(d.Format("YYYY-MM-DD"), "2024-01-10")
+	a.EqualNow(d.Format("dddd, MMMM Do YYYY"), "Wednesday, January 10th 2024")
+	a.EqualNow(d.Format("GGGG-[W]WW-E Q"), "2024-W02-3 1")
+	// the time tokens are the literal text
+	a.EqualNow(d.Format("YYYY-MM-DD HH:mm"), "2024-01-10 HH:mm")
+
+	parsed, err := date.ParseCivilDate("DD/MM/YYYY", "10/01/2024")
+	a.NilNow(err)
+	a.EqualNow(parsed, d)
+
+	parsed, err = date.ParseCivilDate("MMMM D, YYYY", "January 10, 2024")
+	a.NilNow(err)
+	a.EqualNow(parsed, d)
+
+	_, err = date.ParseCivilDate("YYYY-MM-DD HH:mm", "2024-01-10 09:30")
+	a.NotNilNow(err)
+	a.TrueNow(errors.Is(err, date.ErrUnexpectedText))
+
+	// the invalid dates are rejected instead of normalized
+	_, err = date.ParseCivilDate("YYYY-MM-DD", "2024-02-30")
+	a.TrueNow(errors.Is(err, date.ErrRange))
+	var unmarshaled date.CivilDate
+	a.TrueNow(errors.Is(unmarshaled.UnmarshalText([]byte("2024-13-01")), date.ErrRange))
+}
+
+func TestCivilDateJSON(t *testing.T) {
+	a := assert.New(t)
+
+	type person struct {
+		Birthday date.CivilDate `json:"birthday"`
+	}
+
+	data, err := json.Marshal(person{Birthday: date.NewCivilDate(1990, time.May, 3)})
+	a.NilNow(err)
+	a.EqualNow(string(data), `{"birthday":"1990-05-03"}`)
+
+	var p person
+	a.NilNow(json.Unmarshal([]byte(`{"birthday":"1990-05-03"}`), &p))
+	a.EqualNow(p.Birthday, date.NewCivilDate(1990, time.May, 3))
+
+	a.NilNow(json.Unmarshal([]byte(`{"birthday":null}`), &p))
+	a.EqualNow(p.Birthday, date.NewCivilDate(1990, time.May, 3))
+
+	a.NilNow(json.Unmarshal([]byte(`{"birthday":""}`), &p))
+	a.TrueNow(p.Birthday.IsZero())
+
+	a.NotNilNow(json.Unmarshal([]byte(`{"birthday":"05/03/1990"}`), &p))
+
+	text, err := date.NewCivilDate(1990, time.May, 3).MarshalText()
+	a.NilNow(err)
+	a.EqualNow(string(text), "1990-05-03")
+
+	// the zero date round-trips as an empty string
+	p = person{Birthday: date.NewCivilDate(1990, time.May, 3)}
+	data, err = json.Marshal(person{})
+	a.NilNow(err)
+	a.EqualNow(string(data), `{"birthday":""}`)
+	a.NilNow(json.Unmarshal(data, &p))
+	a.TrueNow(p.Birthday.IsZero())
+
+	text, err = date.CivilDate{}.MarshalText()
+	a.NilNow(err)
+	a.EqualNow(string(text), "")
+	a.EqualNow(date.CivilDate{}.String(), "")
+}
+
+func TestCivilDateSQL(t *testing.T) {
+	a := assert.New(t)
+	loc := time.FixedZone("UTC+8", 8*60*60)
+
+	db, err := sql.Open("date-echo", "")
+	a.NilNow(err)
+	defer db.Close()
+
+	cases := []struct {
+		value  any
+		expect date.CivilDate
+	}{
+		{time.Date(2024, 1, 10, 23, 0, 0, 0, loc), date.NewCivilDate(2024, time.January, 10)},
+		{"2024-01-10", date.NewCivilDate(2024, time.January, 10)},
+		{[]byte("2024-01-10T00:00:00Z"), date.NewCivilDate(2024, time.January, 10)},
+		{nil, date.CivilDate{}},
+	}
+
+	for _, c := range cases {
+		var d date.CivilDate
+		a.NilNow(db.QueryRow("SELECT ?", c.value).Scan(&d), c.value)
+		a.EqualNow(d, c.expect, c.value)
+	}
+
+	var d date.CivilDate
+	err = db.QueryRow("SELECT ?", int64(1)).Scan(&d)
+	a.TrueNow(errors.Is(err, date.ErrUnsupportedType))
+
+	_, err = db.Exec("INSERT", date.NewCivilDate(2024, time.January, 10))
+	a.NilNow(err)
+	a.EqualNow(echo.args[0], "2024-01-10")
+
+	_, err = db.Exec("INSERT", date.CivilDate{})
+	a.NilNow(err)
+	a.NilNow(echo.args[0])
+}
@@ -1,9 +1,33 @@
 package date
 
-import "errors"
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
 
 var (
-	ErrNotTime error = errors.New("not a Time")
+	ErrNotTime       error = errors.New("not a Time")
+	ErrUnknownLocale error = errors.New("unknown locale")
+	ErrUnknownFormat error = errors.New("unknown time format")
+	// ErrInvalidLayout is the error that the layout cannot be compiled by CompileLayout, for
+	// example it has an unclosed bracket.
+	ErrInvalidLayout error = errors.New("invalid layout")
+	// ErrUnsupportedType is the error that the value cannot be converted to a Time, for example
+	// scanning a float from the database.
+	ErrUnsupportedType error = errors.New("unsupported type")
+	// ErrYearRange is the error that the time cannot be marshaled because the year is outside of
+	// the range [0,9999], like time.Time.MarshalJSON.
+	ErrYearRange error = errors.New("year outside of range [0,9999]")
+
+	// ErrRange is the cause of the ParseError that a field is out of range, or it does not match
+	// the other fields, for example "2024-02-31" or "Monday, 2024-01-10".
+	ErrRange error = errors.New("value out of range")
+	// ErrUnexpectedText is the cause of the ParseError that the value has a character that cannot
+	// be parsed by the layout element.
+	ErrUnexpectedText error = errors.New("unexpected text")
+	// ErrTooShort is the cause of the ParseError that the value ends before the layout element.
+	ErrTooShort error = errors.New("value too short")
 )
 
 // ParseError is the error that happens when parsing the time string by the layout.
@@ -11,22 +35,145 @@ type ParseError struct {
 	Layout     string
 	Value      string
 	LayoutElem string
-	ValueElem  string
+	// ValueElem is the element of the value for the layout element. It ends at the first character
+	// that cannot be parsed, or it's the whole element if the field is out of range.
+	ValueElem string
+	// Offset is the byte offset in the value of the first character that cannot be parsed, or the
+	// beginning of the element if the field is out of range. It's the length of the value if the
+	// value is too short.
+	Offset int
+	// Expected describes the layout element, for example "2-digit month" or `"-"` for the literal
+	// text.
+	Expected string
+	// Message describes the reason of the error, for example "month out of range 1..12". It's
+	// empty if the value cannot be parsed by the layout element.
+	Message string
+	// Err is the cause of the error, it's one of ErrRange, ErrUnexpectedText, and ErrTooShort.
+	Err error
 }
 
 func (pe *ParseError) Error() string {
-	return `parsing time "` +
+	if pe.LayoutElem == "" {
+		// the error of the whole value, for example the extra text after the layout
+		return `parsing time "` + pe.Value + `" as "` + pe.Layout + `": ` + pe.Message
+	}
+
+	msg := `parsing time "` +
 		pe.Value + `" as "` +
 		pe.Layout + `": cannot parse "` +
 		pe.LayoutElem + `" as "` +
 		pe.ValueElem + `"`
+	if pe.Message != "" {
+		msg += ": " + pe.Message
+	} else if pe.Expected != "" {
+		msg += ": expected " + pe.Expected
+	}
+
+	return msg
+}
+
+// Unwrap returns the cause of the error.
+func (pe *ParseError) Unwrap() error {
+	return pe.Err
+}
+
+// MultiParseError is the error that the value cannot be parsed by any of the layouts. It wraps
+// the errors of each layout, and they can be checked by errors.Is and errors.As.
+type MultiParseError struct {
+	Value string
+	// Errors is the errors of the layouts, in the order of the layouts.
+	Errors []error
+}
+
+func (me *MultiParseError) Error() string {
+	if len(me.Errors) == 0 {
+		return `parsing time "` + me.Value + `": no layout`
+	}
+
+	msgs := make([]string, 0, len(me.Errors))
+	for _, err := range me.Errors {
+		msgs = append(msgs, err.Error())
+	}
+
+	return `parsing time "` + me.Value + `": no layout matched: ` + strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the errors of the layouts.
+func (me *MultiParseError) Unwrap() []error {
+	return me.Errors
+}
+
+// NaturalParseError is the error that happens when parsing the natural language expression.
+type NaturalParseError struct {
+	Expr string
+	// Word is the first unrecognized word of the expression, it's empty if the expression ends
+	// unexpectedly.
+	Word string
+	// Offset is the byte offset of the word in the expression.
+	Offset int
+}
+
+func (ne *NaturalParseError) Error() string {
+	msg := `parsing natural time "` + ne.Expr + `": `
+	if ne.Word == "" {
+		msg += "unexpected end of expression"
+	} else {
+		msg += `unrecognized word "` + ne.Word + `"`
+	}
+
+	return msg + " at offset " + strconv.Itoa(ne.Offset)
+}
+
+// newParseError returns the error that the rest of the value cannot be parsed by the layout token,
+// it finds the first character that cannot be parsed in the rest of the value.
+func newParseError(layout, value string, tok layoutToken, rest string) error {
+	offset, elem, cause := failedElem(tok, rest)
+
+	var message string
+	if cause == ErrRange {
+		message = describeToken(tok) + " out of range"
+	}
+
+	return &ParseError{
+		Layout:     layout,
+		Value:      value,
+		LayoutElem: tok.value,
+		ValueElem:  elem,
+		Offset:     len(value) - len(rest) + offset,
+		Expected:   describeToken(tok),
+		Message:    message,
+		Err:        cause,
+	}
+}
+
+// newExtraTextError returns the error that the value has the text after the end of the layout.
+func newExtraTextError(layout, value, rest string) error {
+	return &ParseError{
+		Layout:    layout,
+		Value:     value,
+		ValueElem: rest,
+		Offset:    len(value) - len(rest),
+		Message:   `extra text: "` + rest + `"`,
+		Err:       ErrUnexpectedText,
+	}
+}
+
+// newRangeError returns the error that the element of the value is out of range, or it does not
+// match the other fields.
+func newRangeError(layout, value string, elem parseElem, message string) error {
+	return newElemError(layout, value, elem, message, ErrRange)
 }
 
-func newParseError(layout, value, layoutElem, valueElem string) error {
+// newElemError returns the error of the element of the value with the message and the cause.
+func newElemError(layout, value string, elem parseElem, message string, cause error) error {
 	return &ParseError{
 		Layout:     layout,
 		Value:      value,
-		LayoutElem: layoutElem,
-		ValueElem:  valueElem,
+		LayoutElem: elem.tok.value,
+		ValueElem:  elem.value,
+		Offset:     elem.offset,
+		Expected:   describeToken(elem.tok),
+		Message:    message,
+		Err:        cause,
 	}
 }
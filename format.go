@@ -1,7 +1,9 @@
 package date
 
 import (
+	"strconv"
 	"strings"
+	"time"
 )
 
 const (
@@ -53,6 +55,12 @@ const (
 	layoutTokenMillisecondTen
 	// layoutTokenMillisecondThree is the three-digits millisecond.
 	layoutTokenMillisecond
+	// layoutTokenMicrosecond is the six-digits microsecond.
+	layoutTokenMicrosecond
+	// layoutTokenNanosecond is the nine-digits nanosecond.
+	layoutTokenNanosecond
+	// layoutTokenFraction is the fractional second without trailing zeros.
+	layoutTokenFraction
 	// layoutTokenPMUpper is post or ante meridiem in upper-case.
 	layoutTokenPMUpper
 	// layoutTokenPMLower is post or ante meridiem in upper-case.
@@ -61,6 +69,69 @@ const (
 	layoutTokenTZ
 	// layoutTokenTZColon is the timezone offset from UTC that separate by colon.
 	layoutTokenTZColon
+	// layoutTokenTZAbbr is the abbreviation of the timezone.
+	layoutTokenTZAbbr
+	// layoutTokenTZName is the IANA name of the timezone location.
+	layoutTokenTZName
+	// layoutTokenTZHour is the hours of the timezone offset from UTC.
+	layoutTokenTZHour
+	// layoutTokenTZISO is the timezone offset from UTC, or "Z" for UTC.
+	layoutTokenTZISO
+	// layoutTokenTZISOColon is the timezone offset from UTC that separate by colon, or "Z" for UTC.
+	layoutTokenTZISOColon
+	// layoutTokenTZISOHour is the hours of the timezone offset from UTC, or "Z" for UTC.
+	layoutTokenTZISOHour
+	// layoutTokenDaySpace is the space-padded day.
+	layoutTokenDaySpace
+	// layoutTokenDayOfYearSpace is the space-padded three-characters day of year.
+	layoutTokenDayOfYearSpace
+	// layoutTokenDayOfYearLong is the three-digits day of year.
+	layoutTokenDayOfYearLong
+	// layoutTokenFractionFixed is the fractional second with a fixed number of digits, and the
+	// period or comma separator.
+	layoutTokenFractionFixed
+	// layoutTokenFractionTrim is the fractional second without trailing zeros, and the period or
+	// comma separator. It's omitted if the fractional second is zero.
+	layoutTokenFractionTrim
+	// layoutTokenISOWeek is the ISO 8601 week number beginning at 1.
+	layoutTokenISOWeek
+	// layoutTokenISOWeekLong is the two-digits ISO 8601 week number.
+	layoutTokenISOWeekLong
+	// layoutTokenISOWeekYear is the two-digits ISO 8601 week-based year.
+	layoutTokenISOWeekYear
+	// layoutTokenISOWeekYearLong is the ISO 8601 week-based year.
+	layoutTokenISOWeekYearLong
+	// layoutTokenISOWeekday is the ISO 8601 day of week beginning at 1 (Monday).
+	layoutTokenISOWeekday
+	// layoutTokenDayOfYear is the day of year beginning at 1.
+	layoutTokenDayOfYear
+	// layoutTokenQuarter is the quarter of year beginning at 1.
+	layoutTokenQuarter
+	// layoutTokenDayOrdinal is the day of month with the ordinal suffix.
+	layoutTokenDayOrdinal
+	// layoutTokenMonthOrdinal is the month with the ordinal suffix.
+	layoutTokenMonthOrdinal
+	// layoutTokenQuarterOrdinal is the quarter of year with the ordinal suffix.
+	layoutTokenQuarterOrdinal
+	// layoutTokenDayOfWeekOrdinal is the day of week beginning at 0 (Sunday) with the ordinal
+	// suffix.
+	layoutTokenDayOfWeekOrdinal
+	// layoutTokenCentury is the two-digits century, the year divided by 100.
+	layoutTokenCentury
+	// layoutTokenHourSpace is the space-padded two-characters hour of 24-hour clock.
+	layoutTokenHourSpace
+	// layoutTokenHour12Space is the space-padded two-characters hour of 12-hour clock.
+	layoutTokenHour12Space
+	// layoutTokenWeekSunday is the two-digits week of year, the first Sunday is the first day of
+	// week 1.
+	layoutTokenWeekSunday
+	// layoutTokenWeekMonday is the two-digits week of year, the first Monday is the first day of
+	// week 1.
+	layoutTokenWeekMonday
+	// layoutTokenUnix is the seconds since the Unix epoch.
+	layoutTokenUnix
+	// layoutTokenUnixMilli is the milliseconds since the Unix epoch.
+	layoutTokenUnixMilli
 )
 
 var abbrMonthNames = []string{
@@ -127,6 +198,10 @@ func nextLayoutToken(layout string) (int, string, string) {
 			return layoutTokenYear, layout[0:2], layout[2:]
 		}
 	case 'M':
+		if strings.HasPrefix(layout, "MST") {
+			return layoutTokenTZAbbr, layout[0:3], layout[3:]
+		}
+
 		if strings.HasPrefix(layout, "Monday") {
 			return layoutTokenDayOfWeekFull, layout[0:6], layout[6:]
 		} else if strings.HasPrefix(layout, "Mon") {
@@ -139,6 +214,8 @@ func nextLayoutToken(layout string) (int, string, string) {
 			return layoutTokenMonthAbbr, layout[0:3], layout[3:]
 		} else if strings.HasPrefix(layout, "MM") {
 			return layoutTokenMonthLong, layout[0:2], layout[2:]
+		} else if strings.HasPrefix(layout, "Mo") {
+			return layoutTokenMonthOrdinal, layout[0:2], layout[2:]
 		} else {
 			return layoutTokenMonth, layout[0:1], layout[1:]
 		}
@@ -151,8 +228,14 @@ func nextLayoutToken(layout string) (int, string, string) {
 			}
 		}
 	case 'D':
-		if strings.HasPrefix(layout, "DD") {
+		if strings.HasPrefix(layout, "DDDD") {
+			return layoutTokenDayOfYearLong, layout[0:4], layout[4:]
+		} else if strings.HasPrefix(layout, "DDD") {
+			return layoutTokenDayOfYear, layout[0:3], layout[3:]
+		} else if strings.HasPrefix(layout, "DD") {
 			return layoutTokenDayLong, layout[0:2], layout[2:]
+		} else if strings.HasPrefix(layout, "Do") {
+			return layoutTokenDayOrdinal, layout[0:2], layout[2:]
 		} else {
 			return layoutTokenDay, layout[0:1], layout[1:]
 		}
@@ -161,6 +244,8 @@ func nextLayoutToken(layout string) (int, string, string) {
 			return layoutTokenDayOfWeekFull, layout[0:4], layout[4:]
 		} else if strings.HasPrefix(layout, "ddd") {
 			return layoutTokenDayOfWeekAbbr, layout[0:3], layout[3:]
+		} else if strings.HasPrefix(layout, "do") {
+			return layoutTokenDayOfWeekOrdinal, layout[0:2], layout[2:]
 		} else {
 			return layoutTokenDayOfWeek, layout[0:1], layout[1:]
 		}
@@ -189,13 +274,42 @@ func nextLayoutToken(layout string) (int, string, string) {
 			return layoutTokenSecond, layout[0:1], layout[1:]
 		}
 	case 'S':
-		if strings.HasPrefix(layout, "SSS") {
+		if strings.HasPrefix(layout, "SSSSSSSSS") {
+			return layoutTokenNanosecond, layout[0:9], layout[9:]
+		} else if strings.HasPrefix(layout, "SSSSSS") {
+			return layoutTokenMicrosecond, layout[0:6], layout[6:]
+		} else if strings.HasPrefix(layout, "SSS") {
 			return layoutTokenMillisecond, layout[0:3], layout[3:]
 		} else if strings.HasPrefix(layout, "SS") {
 			return layoutTokenMillisecondTen, layout[0:2], layout[2:]
 		} else {
 			return layoutTokenMillisecondHundred, layout[0:1], layout[1:]
 		}
+	case 'F':
+		return layoutTokenFraction, layout[0:1], layout[1:]
+	case 'W':
+		if strings.HasPrefix(layout, "WW") {
+			return layoutTokenISOWeekLong, layout[0:2], layout[2:]
+		} else {
+			return layoutTokenISOWeek, layout[0:1], layout[1:]
+		}
+	case 'G':
+		if strings.HasPrefix(layout, "GGGG") {
+			return layoutTokenISOWeekYearLong, layout[0:4], layout[4:]
+		} else if strings.HasPrefix(layout, "GG") {
+			return layoutTokenISOWeekYear, layout[0:2], layout[2:]
+		}
+	case 'E':
+		return layoutTokenISOWeekday, layout[0:1], layout[1:]
+	case 'X':
+		return layoutTokenUnix, layout[0:1], layout[1:]
+	case 'x':
+		return layoutTokenUnixMilli, layout[0:1], layout[1:]
+	case 'Q':
+		if strings.HasPrefix(layout, "Qo") {
+			return layoutTokenQuarterOrdinal, layout[0:2], layout[2:]
+		}
+		return layoutTokenQuarter, layout[0:1], layout[1:]
 	case 'A':
 		return layoutTokenPMUpper, layout[0:1], layout[1:]
 	case 'a':
@@ -204,17 +318,72 @@ func nextLayoutToken(layout string) (int, string, string) {
 		if strings.HasPrefix(layout, "PM") {
 			return layoutTokenPMUpper, layout[0:2], layout[2:]
 		}
+	case 'p':
+		if strings.HasPrefix(layout, "pm") {
+			return layoutTokenPMLower, layout[0:2], layout[2:]
+		}
 	case 'Z':
+		if strings.HasPrefix(layout, "Z07:00") {
+			return layoutTokenTZISOColon, layout[0:6], layout[6:]
+		} else if strings.HasPrefix(layout, "Z0700") {
+			return layoutTokenTZISO, layout[0:5], layout[5:]
+		} else if strings.HasPrefix(layout, "Z07") {
+			return layoutTokenTZISOHour, layout[0:3], layout[3:]
+		}
+
 		if strings.HasPrefix(layout, "ZZ") {
 			return layoutTokenTZ, layout[0:2], layout[2:]
 		} else {
 			return layoutTokenTZColon, layout[0:1], layout[1:]
 		}
+	case 'z':
+		if strings.HasPrefix(layout, "zz") {
+			return layoutTokenTZName, layout[0:2], layout[2:]
+		} else {
+			return layoutTokenTZAbbr, layout[0:1], layout[1:]
+		}
 	case '\\': // Escape next character
 		if len(layout) >= 2 {
 			return layoutTokenNone, layout[1:2], layout[2:]
 		}
+	case '[': // Literal text until the closing bracket
+		if text, suffix, ok := readBracketLiteral(layout); ok {
+			return layoutTokenNone, text, suffix
+		}
+	case '-':
+		if strings.HasPrefix(layout, "-07:00") {
+			return layoutTokenTZColon, layout[0:6], layout[6:]
+		} else if strings.HasPrefix(layout, "-0700") {
+			return layoutTokenTZ, layout[0:5], layout[5:]
+		} else if strings.HasPrefix(layout, "-07") {
+			return layoutTokenTZHour, layout[0:3], layout[3:]
+		}
+	case '_':
+		if strings.HasPrefix(layout, "__2") {
+			return layoutTokenDayOfYearSpace, layout[0:3], layout[3:]
+		} else if strings.HasPrefix(layout, "_2") && !strings.HasPrefix(layout, "_2006") {
+			return layoutTokenDaySpace, layout[0:2], layout[2:]
+		}
+	case '.', ',': // .000, ,000, .999 or ,999 for fractional second
+		if len(layout) < 2 || (layout[1] != '0' && layout[1] != '9') {
+			break
+		}
+		i := 2
+		for i < len(layout) && layout[i] == layout[1] {
+			i++
+		}
+		if i < len(layout) && layout[i] >= '0' && layout[i] <= '9' {
+			break
+		}
+		if layout[1] == '0' {
+			return layoutTokenFractionFixed, layout[0:i], layout[i:]
+		} else {
+			return layoutTokenFractionTrim, layout[0:i], layout[i:]
+		}
 	case '0':
+		if strings.HasPrefix(layout, "002") {
+			return layoutTokenDayOfYearLong, layout[0:3], layout[3:]
+		}
 		if len(layout) < 2 {
 			break
 		}
@@ -260,111 +429,241 @@ func nextLayoutToken(layout string) (int, string, string) {
 // AppendFormat is like Format but appends the textual representation to b and returns the extended
 // buffer.
 func (t Time) AppendFormat(b []byte, layout string) []byte {
-	buf := t.formatByLayout(layout, b)
+	buf := getLayout(layout).appendFormat(b, t, English)
 	return buf
 }
 
 // Format returns a string of the time formatted by the layout from the parameter.
 func (t Time) Format(layout string) string {
 	buf := make([]byte, 0, 64)
-	buf = t.formatByLayout(layout, buf)
+	buf = getLayout(layout).appendFormat(buf, t, English)
 
 	return string(buf)
 }
 
-// formatByLayout appends the string of the time formatted by the layout into the buffer, and
-// returns the reference of the buffer.
-func (t Time) formatByLayout(layout string, buf []byte) []byte {
-	for {
-		token, str, suffix := nextLayoutToken(layout)
-		layout = suffix
-		if token == layoutTokenEnd {
-			break
-		} else if token == layoutTokenNone {
-			buf = append(buf, str...)
+// FormatLocale is like Format but uses the names and the markers of the locale for the name
+// tokens. It uses the default locale (English) if the locale is nil.
+func (t Time) FormatLocale(layout string, locale *Locale) string {
+	buf := make([]byte, 0, 64)
+	buf = getLayout(layout).appendFormat(buf, t, getLocale(locale))
+
+	return string(buf)
+}
+
+// hasUnixFraction reports whether the layout has the Unix timestamp in seconds and a fractional
+// second, the fractional second is the fraction of the timestamp.
+func (l *Layout) hasUnixFraction() bool {
+	hasUnix, hasFraction := false, false
+	for _, tok := range l.tokens {
+		switch tok.kind {
+		case layoutTokenUnix:
+			hasUnix = true
+		case layoutTokenMillisecondHundred, layoutTokenMillisecondTen, layoutTokenMillisecond,
+			layoutTokenMicrosecond, layoutTokenNanosecond, layoutTokenFraction,
+			layoutTokenFractionFixed, layoutTokenFractionTrim:
+			hasFraction = true
+		}
+	}
+
+	return hasUnix && hasFraction
+}
+
+// appendFormat appends the string of the time formatted by the compiled layout and the locale into
+// the buffer, and returns the reference of the buffer.
+func (l *Layout) appendFormat(buf []byte, t Time, locale *Locale) []byte {
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	unix, nsec := t.Unix(), t.Nanosecond()
+	negUnix := false
+	if unix < 0 && nsec > 0 && l.hasUnixFraction() {
+		// the negative timestamp with the fraction is in sign-magnitude like "-1.500", the same as
+		// parsing, instead of the floored seconds and the positive fraction
+		unix, nsec, negUnix = unix+1, int(time.Second)-nsec, true
+	}
+
+	for _, tok := range l.tokens {
+		token := tok.kind
+		if token == layoutTokenNone {
+			buf = append(buf, tok.value...)
 			continue
 		}
+		if tok.pad != 0 {
+			if num, width, ok := layoutNumber(token, t); ok {
+				buf = appendPadded(buf, num, width, tok.pad)
+				continue
+			}
+		}
 
 		switch token {
 		case layoutTokenYearLong:
-			buf = appendIntToBuffer(buf, t.Year(), 4)
+			buf = appendIntToBuffer(buf, year, 4)
 		case layoutTokenYear:
-			buf = appendIntToBuffer(buf, t.Year()%100, 2)
+			buf = appendIntToBuffer(buf, year%100, 2)
 		case layoutTokenMonth:
-			buf = appendIntToBuffer(buf, int(t.Month()), 0)
+			buf = appendIntToBuffer(buf, int(month), 0)
 		case layoutTokenMonthLong:
-			buf = appendIntToBuffer(buf, int(t.Month()), 2)
+			buf = appendIntToBuffer(buf, int(month), 2)
 		case layoutTokenMonthAbbr:
-			abbr := abbrMonthNames[t.Month()-1]
+			abbr := locale.monthsShort()[month-1]
 			buf = append(buf, abbr...)
 		case layoutTokenMonthFull:
-			name := fullMonthNames[t.Month()-1]
+			name := locale.months()[month-1]
 			buf = append(buf, name...)
 		case layoutTokenDay:
-			buf = appendIntToBuffer(buf, t.Day(), 1)
+			buf = appendIntToBuffer(buf, day, 1)
 		case layoutTokenDayLong:
-			buf = appendIntToBuffer(buf, t.Day(), 2)
+			buf = appendIntToBuffer(buf, day, 2)
 		case layoutTokenDayOfWeek:
 			buf = appendIntToBuffer(buf, int(t.Weekday()), 1)
 		case layoutTokenDayOfWeekAbbr:
-			abbr := abbrWeekdayNames[t.Weekday()]
+			abbr := locale.weekdaysShort()[t.Weekday()]
 			buf = append(buf, abbr...)
 		case layoutTokenDayOfWeekFull:
-			name := fullWeekdayNames[t.Weekday()]
+			name := locale.weekdays()[t.Weekday()]
 			buf = append(buf, name...)
 		case layoutTokenHour:
-			buf = appendIntToBuffer(buf, t.Hour(), 1)
+			buf = appendIntToBuffer(buf, hour, 1)
 		case layoutTokenHourLong:
-			buf = appendIntToBuffer(buf, t.Hour(), 2)
+			buf = appendIntToBuffer(buf, hour, 2)
 		case layoutTokenHour12:
 			buf = appendIntToBuffer(buf, t.Hour12(), 1)
 		case layoutTokenHour12Long:
 			buf = appendIntToBuffer(buf, t.Hour12(), 2)
+		case layoutTokenHourSpace:
+			buf = appendPadded(buf, hour, 2, padSpace)
+		case layoutTokenHour12Space:
+			buf = appendPadded(buf, t.Hour12(), 2, padSpace)
 		case layoutTokenMinute:
-			buf = appendIntToBuffer(buf, t.Minute(), 1)
+			buf = appendIntToBuffer(buf, min, 1)
 		case layoutTokenMinuteLong:
-			buf = appendIntToBuffer(buf, t.Minute(), 2)
+			buf = appendIntToBuffer(buf, min, 2)
 		case layoutTokenSecond:
-			buf = appendIntToBuffer(buf, t.Second(), 1)
+			buf = appendIntToBuffer(buf, sec, 1)
 		case layoutTokenSecondLong:
-			buf = appendIntToBuffer(buf, t.Second(), 2)
+			buf = appendIntToBuffer(buf, sec, 2)
 		case layoutTokenMillisecondHundred:
-			buf = appendIntToBuffer(buf, t.Millisecond()/100, 1)
+			buf = appendIntToBuffer(buf, nsec/1e8, 1)
 		case layoutTokenMillisecondTen:
-			buf = appendIntToBuffer(buf, t.Millisecond()/10, 2)
+			buf = appendIntToBuffer(buf, nsec/1e7, 2)
 		case layoutTokenMillisecond:
-			buf = appendIntToBuffer(buf, t.Millisecond(), 3)
+			buf = appendIntToBuffer(buf, nsec/1e6, 3)
+		case layoutTokenMicrosecond:
+			buf = appendIntToBuffer(buf, nsec/1e3, 6)
+		case layoutTokenNanosecond:
+			buf = appendIntToBuffer(buf, nsec, 9)
+		case layoutTokenFraction:
+			buf = appendFraction(buf, nsec)
 		case layoutTokenPMUpper:
-			hour := t.Hour()
-			if hour >= 12 {
-				buf = append(buf, "PM"...)
-			} else {
-				buf = append(buf, "AM"...)
-			}
+			buf = append(buf, locale.meridiems(false)[hour/12]...)
 		case layoutTokenPMLower:
-			hour := t.Hour()
-			if hour >= 12 {
-				buf = append(buf, "pm"...)
-			} else {
-				buf = append(buf, "am"...)
-			}
-		case layoutTokenTZ, layoutTokenTZColon:
+			buf = append(buf, locale.meridiems(true)[hour/12]...)
+		case layoutTokenTZ, layoutTokenTZColon, layoutTokenTZHour:
 			_, offset := t.Zone()
-			zone := offset / 60
-			if zone < 0 {
-				buf = append(buf, '-')
-				zone = -zone
+			buf = appendOffset(buf, offset, token)
+		case layoutTokenTZISO, layoutTokenTZISOColon, layoutTokenTZISOHour:
+			_, offset := t.Zone()
+			if offset == 0 {
+				buf = append(buf, 'Z')
 			} else {
-				buf = append(buf, '+')
+				buf = appendOffset(buf, offset, token)
+			}
+		case layoutTokenTZAbbr:
+			name, offset := t.Zone()
+			if name != "" {
+				buf = append(buf, name...)
+				break
 			}
 
-			buf = appendIntToBuffer(buf, zone/60, 2)
-			if token == layoutTokenTZColon {
-				buf = append(buf, ':')
+			// use the offset if the zone has no abbreviation
+			buf = appendOffset(buf, offset, layoutTokenTZ)
+		case layoutTokenTZName:
+			buf = append(buf, t.Location().String()...)
+		case layoutTokenDaySpace:
+			if day < 10 {
+				buf = append(buf, ' ')
+			}
+			buf = appendIntToBuffer(buf, day, 1)
+		case layoutTokenDayOfYearSpace:
+			yday := t.YearDay()
+			if yday < 100 {
+				buf = append(buf, ' ')
+			}
+			if yday < 10 {
+				buf = append(buf, ' ')
+			}
+			buf = appendIntToBuffer(buf, yday, 1)
+		case layoutTokenDayOfYear:
+			buf = appendIntToBuffer(buf, t.YearDay(), 1)
+		case layoutTokenDayOfYearLong:
+			buf = appendIntToBuffer(buf, t.YearDay(), 3)
+		case layoutTokenQuarter:
+			buf = appendIntToBuffer(buf, (int(month)-1)/3+1, 1)
+		case layoutTokenDayOrdinal:
+			buf = appendOrdinal(buf, day, locale)
+		case layoutTokenMonthOrdinal:
+			buf = appendOrdinal(buf, int(month), locale)
+		case layoutTokenQuarterOrdinal:
+			buf = appendOrdinal(buf, (int(month)-1)/3+1, locale)
+		case layoutTokenDayOfWeekOrdinal:
+			buf = appendOrdinal(buf, int(t.Weekday()), locale)
+		case layoutTokenCentury:
+			buf = appendIntToBuffer(buf, year/100, 2)
+		case layoutTokenWeekSunday:
+			buf = appendIntToBuffer(buf, weekOfYear(t, time.Sunday), 2)
+		case layoutTokenWeekMonday:
+			buf = appendIntToBuffer(buf, weekOfYear(t, time.Monday), 2)
+		case layoutTokenUnix:
+			if negUnix {
+				buf = append(buf, '-')
+				unix = -unix
+			}
+			buf = strconv.AppendInt(buf, unix, 10)
+		case layoutTokenUnixMilli:
+			buf = strconv.AppendInt(buf, t.UnixMilli(), 10)
+		case layoutTokenFractionFixed, layoutTokenFractionTrim:
+			buf = appendNano(buf, nsec, tok.value[0], len(tok.value)-1,
+				token == layoutTokenFractionTrim)
+		case layoutTokenISOWeek, layoutTokenISOWeekLong:
+			_, week := t.ISOWeek()
+			width := 1
+			if token == layoutTokenISOWeekLong {
+				width = 2
 			}
-			buf = appendIntToBuffer(buf, zone%60, 2)
+			buf = appendIntToBuffer(buf, week, width)
+		case layoutTokenISOWeekYear:
+			year, _ := t.ISOWeek()
+			buf = appendIntToBuffer(buf, year%100, 2)
+		case layoutTokenISOWeekYearLong:
+			year, _ := t.ISOWeek()
+			buf = appendIntToBuffer(buf, year, 4)
+		case layoutTokenISOWeekday:
+			buf = appendIntToBuffer(buf, (int(t.Weekday())+6)%7+1, 1)
 		}
 	}
 
 	return buf
 }
+
+// appendOffset appends the timezone offset (in seconds east of UTC) in the form of the token, for
+// example "-0700" for layoutTokenTZ, "-07:00" for layoutTokenTZColon, and "-07" for
+// layoutTokenTZHour.
+func appendOffset(buf []byte, offset int, token int) []byte {
+	zone := offset / 60
+	if zone < 0 {
+		buf = append(buf, '-')
+		zone = -zone
+	} else {
+		buf = append(buf, '+')
+	}
+
+	buf = appendIntToBuffer(buf, zone/60, 2)
+	switch token {
+	case layoutTokenTZHour, layoutTokenTZISOHour:
+		return buf
+	case layoutTokenTZColon, layoutTokenTZISOColon:
+		buf = append(buf, ':')
+	}
+	buf = appendIntToBuffer(buf, zone%60, 2)
+
+	return buf
+}
@@ -2,6 +2,7 @@ package date_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -80,10 +81,106 @@ func TestFormat(t *testing.T) {
 			date.Date(2006, time.January, 2, 15, 4, 5, 0, tzSH),
 			"YYYY-MM-DD HH:mm:ss ZZ", "2006-01-02 15:04:05 +0800",
 		},
+		{
+			date.Date(2006, time.January, 2, 15, 4, 5, 123456789),
+			"HH:mm:ss.SSSSSS", "15:04:05.123456",
+		},
+		{
+			date.Date(2006, time.January, 2, 15, 4, 5, 1000),
+			"HH:mm:ss.SSSSSSSSS", "15:04:05.000001000",
+		},
+		{
+			date.Date(2006, time.January, 2, 15, 4, 5, 123450000),
+			"HH:mm:ss.F", "15:04:05.12345",
+		},
+		{
+			date.Date(2006, time.January, 2, 15, 4, 5, 0),
+			"HH:mm:ss.F", "15:04:05.0",
+		},
+		{
+			date.Date(2006, time.January, 2, 15, 4, 5, 0, tzSH),
+			"YYYY-MM-DD HH:mm:ss z", "2006-01-02 15:04:05 CST",
+		},
+		{
+			date.Date(2006, time.January, 2, 15, 4, 5, 0, tzLA),
+			"YYYY-MM-DD HH:mm:ss zz", "2006-01-02 15:04:05 America/Los_Angeles",
+		},
+		{
+			date.Date(2006, time.January, 2, 15, 4, 5, 0, time.FixedZone("", -3*3600-30*60)),
+			"YYYY-MM-DD HH:mm:ss z", "2006-01-02 15:04:05 -0330",
+		},
 		{
 			date.Date(2006, time.January, 2, 15, 4, 5, 0),
 			"2006-01-02T15:04:05", "2006-01-02T15:04:05",
 		},
+		{
+			date.Date(2024, time.January, 16, 15, 4, 5, 0),
+			"GGGG-\\WWW-E", "2024-W03-2",
+		},
+		{
+			date.Date(2021, time.January, 3, 15, 4, 5, 0),
+			"GG \\WW E", "20 W53 7",
+		},
+		{
+			date.Date(2024, time.February, 29, 15, 4, 5, 0),
+			"YYYY-DDDD DDD", "2024-060 60",
+		},
+		{
+			date.Date(2024, time.January, 2, 15, 4, 5, 0),
+			"YYYY-DDDD DDD", "2024-002 2",
+		},
+		{
+			date.Date(2024, time.August, 2, 15, 4, 5, 0),
+			"YYYY \\QQ", "2024 Q3",
+		},
+		{
+			date.Date(2024, time.January, 1, 15, 4, 5, 0),
+			"MMMM Do, YYYY", "January 1st, 2024",
+		},
+		{
+			date.Date(2024, time.March, 22, 15, 4, 5, 0),
+			"Do Mo Qo do", "22nd 3rd 1st 5th",
+		},
+		{
+			date.Date(2024, time.December, 11, 15, 4, 5, 0),
+			"Do Mo Qo do", "11th 12th 4th 3rd",
+		},
+		{
+			date.Date(2024, time.February, 23, 15, 4, 5, 0),
+			"Do Mo Qo do", "23rd 2nd 1st 5th",
+		},
+		{
+			date.Date(2024, time.January, 10, 15, 4, 5, 0),
+			"YYYY-MM-DD[The day] [at] HH:mm", "2024-01-10The day at 15:04",
+		},
+		{
+			date.Date(2024, time.January, 10, 15, 4, 5, 0),
+			"[[YYYY\\]] YYYY \\[MM] [a\\\\b]", "[YYYY] 2024 [01] a\\b",
+		},
+		{
+			date.Date(2024, time.January, 10, 15, 4, 5, 0),
+			"[YYYY", "[2024",
+		},
+		{
+			date.Date(2024, time.January, 11, 0, 0, 0, 123456789),
+			"X x", "1704931200 1704931200123",
+		},
+		{
+			date.Date(1969, time.December, 31, 23, 59, 58, 500000000),
+			"X x", "-2 -1500",
+		},
+		{
+			date.Date(2024, time.January, 11, 0, 0, 0, 123456789),
+			"X.SSS", "1704931200.123",
+		},
+		{
+			date.Date(1969, time.December, 31, 23, 59, 58, 500000000),
+			"X.SSS", "-1.500",
+		},
+		{
+			date.Date(1969, time.December, 31, 23, 59, 59, 750000000),
+			"X.SSSSSS", "-0.250000",
+		},
 	}
 
 	for _, test := range cases {
@@ -98,3 +195,89 @@ func testFormat(a *assert.Assertion, time date.Time, layout, expect string) {
 
 	a.EqualNow(str, expect)
 }
+
+func TestStdlibLayouts(t *testing.T) {
+	a := assert.New(t)
+
+	tzLA, _ := time.LoadLocation("America/Los_Angeles")
+	tzSH, _ := time.LoadLocation("Asia/Shanghai")
+
+	layouts := []string{
+		time.Layout,
+		time.ANSIC,
+		time.UnixDate,
+		time.RubyDate,
+		time.RFC822,
+		time.RFC822Z,
+		time.RFC850,
+		time.RFC1123,
+		time.RFC1123Z,
+		time.RFC3339,
+		time.RFC3339Nano,
+		time.Kitchen,
+		time.Stamp,
+		time.StampMilli,
+		time.StampMicro,
+		time.StampNano,
+		time.DateTime,
+		time.DateOnly,
+		time.TimeOnly,
+	}
+	times := []time.Time{
+		time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC),
+		time.Date(2024, time.December, 31, 0, 0, 59, 999999999, time.UTC),
+		time.Date(2024, time.February, 9, 9, 30, 0, 120000000, tzLA),
+		time.Date(2024, time.July, 19, 12, 0, 0, 123456789, tzLA),
+		time.Date(2024, time.March, 10, 23, 59, 59, 1000, tzSH),
+		time.Date(1969, time.November, 5, 6, 7, 8, 500, time.FixedZone("", -3*3600-30*60)),
+	}
+
+	// the changes of the formatted values, the parsing of the changed values should fail or succeed
+	// like time.Parse
+	changes := []func(string) string{
+		func(str string) string { return str },
+		func(str string) string { return str + " extra" },
+		func(str string) string { return str[:len(str)-1] },
+		func(str string) string { return strings.Replace(str, "UTC", "XYZ", 1) },
+		func(str string) string { return strings.Replace(str, "UTC", "GMT+3", 1) },
+		func(str string) string { return strings.Replace(str, "31", "32", 1) },
+		func(str string) string { return strings.Replace(str, "15:", "25:", 1) },
+	}
+
+	for _, layout := range layouts {
+		for _, tm := range times {
+			str := tm.Format(layout)
+			a.EqualNow(date.New(tm).Format(layout), str, "format %q", layout)
+
+			for _, change := range changes {
+				testStdlibParse(a, layout, change(str), tm.Location())
+			}
+		}
+	}
+}
+
+func testStdlibParse(a *assert.Assertion, layout, str string, loc *time.Location) {
+	a.Helper()
+
+	expected, expectedErr := time.ParseInLocation(layout, str, loc)
+	parsed, err := date.ParseInLocation(layout, str, loc)
+	_, strictErr := date.ParseStrictInLocation(layout, str, loc)
+
+	if expectedErr != nil {
+		// Parse normalizes the out-of-range values and ignores the extra text, and ParseStrict
+		// rejects them like time.Parse
+		a.NotNilNow(strictErr, "parse %q with %q: %v", str, layout, expectedErr)
+		if msg := expectedErr.Error(); !strings.Contains(msg, "out of range") &&
+			!strings.Contains(msg, "extra text") {
+			a.NotNilNow(err, "parse %q with %q: %v", str, layout, expectedErr)
+		}
+		return
+	}
+
+	a.NilNow(err, "parse %q with %q", str, layout)
+	a.NilNow(strictErr, "parse %q with %q", str, layout)
+	_, expectedOffset := expected.Zone()
+	_, offset := parsed.Zone()
+	a.EqualNow(offset, expectedOffset, "parse %q with %q", str, layout)
+	a.TrueNow(parsed.Equal(expected), "parse %q with %q", str, layout)
+}
@@ -0,0 +1,193 @@
+package date
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// layoutCacheSize is the maximum number of the compiled layouts in the cache.
+const layoutCacheSize = 128
+
+// layoutToken is a token of the compiled layout.
+type layoutToken struct {
+	// kind is the type of the token.
+	kind int
+	// value is the text of the token in the layout, or the literal text for layoutTokenNone.
+	value string
+	// pad is the padding of the numeric token, it uses the padding of the token if it's zero.
+	pad byte
+}
+
+// Layout is a compiled layout. It's immutable and safe for concurrent use, and it can be used to
+// format or parse the time without tokenizing the layout again.
+type Layout struct {
+	layout string
+	tokens []layoutToken
+}
+
+// CompileLayout parses the layout and returns the compiled Layout. The characters that are not the
+// tokens are the literal text, and it returns ErrInvalidLayout if the layout has an unclosed
+// bracket or ends with an escaping backslash, which the package-level functions use as the literal
+// text.
+func CompileLayout(layout string) (*Layout, error) {
+	if !isValidLayout(layout) {
+		return nil, ErrInvalidLayout
+	}
+
+	return compileLayout(layout), nil
+}
+
+// MustCompileLayout is like CompileLayout but panics if the layout is invalid. It's useful for the
+// layouts in the global variables.
+func MustCompileLayout(layout string) *Layout {
+	l, err := CompileLayout(layout)
+	if err != nil {
+		panic(err)
+	}
+
+	return l
+}
+
+// isValidLayout reports whether the brackets of the layout are closed, and the layout does not end
+// with an escaping backslash.
+func isValidLayout(layout string) bool {
+	for str := layout; str != ""; {
+		_, _, suffix := nextLayoutToken(str)
+		if (str[0] == '[' || str[0] == '\\') && len(str)-len(suffix) == 1 {
+			// the bracket or the backslash is not used as a quote
+			return false
+		}
+		str = suffix
+	}
+
+	return true
+}
+
+// compileLayout splits the layout into tokens and returns the compiled Layout.
+func compileLayout(layout string) *Layout {
+	l := &Layout{layout: layout}
+
+	for str := layout; ; {
+		token, s, suffix := nextLayoutToken(str)
+		if token == layoutTokenEnd {
+			break
+		}
+		str = suffix
+
+		l.tokens = append(l.tokens, layoutToken{kind: token, value: s})
+	}
+
+	return l
+}
+
+// String returns the source of the layout.
+func (l *Layout) String() string {
+	return l.layout
+}
+
+// AppendFormat is like Format but appends the textual representation to b and returns the extended
+// buffer.
+func (l *Layout) AppendFormat(b []byte, t Time) []byte {
+	return l.appendFormat(b, t, English)
+}
+
+// Format returns a string of the time formatted by the layout.
+func (l *Layout) Format(t Time) string {
+	buf := make([]byte, 0, 64)
+	buf = l.appendFormat(buf, t, English)
+
+	return string(buf)
+}
+
+// Parse parses a formatted string with the layout and returns the time value it represents.
+func (l *Layout) Parse(value string) (Time, error) {
+	return l.parse(value, parseOptions{loc: time.Local, locale: English})
+}
+
+// ParseStrict is like Parse but rejects the values that are out of range instead of normalizing
+// them. See ParseStrict for more details.
+func (l *Layout) ParseStrict(value string) (Time, error) {
+	return l.parse(value, parseOptions{loc: time.Local, locale: English, strict: true})
+}
+
+// layoutCache is a LRU cache of the compiled layouts. The cache hits only take the read lock, and
+// they record the last use of the layout that is used to evict the least recently used layout.
+type layoutCache struct {
+	mutex   sync.RWMutex
+	size    int
+	clock   atomic.Int64
+	layouts map[string]*layoutCacheEntry
+	compile func(string) *Layout
+}
+
+// layoutCacheEntry is a compiled layout in the cache.
+type layoutCacheEntry struct {
+	layout *Layout
+	// used is the clock of the cache when the layout was used last time.
+	used atomic.Int64
+}
+
+var defaultLayoutCache = newLayoutCache(layoutCacheSize, compileLayout)
+
+// newLayoutCache creates a new layout cache with the maximum size, and it uses the compile
+// function to compile the layouts that are not in the cache.
+func newLayoutCache(size int, compile func(string) *Layout) *layoutCache {
+	return &layoutCache{
+		size:    size,
+		layouts: make(map[string]*layoutCacheEntry, size+1),
+		compile: compile,
+	}
+}
+
+// get returns the compiled layout from the cache, or compiles the layout and adds it into the cache
+// if it is not in the cache.
+func (c *layoutCache) get(layout string) *Layout {
+	c.mutex.RLock()
+	entry, ok := c.layouts[layout]
+	c.mutex.RUnlock()
+	if ok {
+		entry.used.Store(c.clock.Add(1))
+		return entry.layout
+	}
+
+	l := c.compile(layout)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if entry, ok := c.layouts[layout]; ok {
+		// the layout was added by another goroutine
+		entry.used.Store(c.clock.Add(1))
+		return entry.layout
+	}
+
+	entry = &layoutCacheEntry{layout: l}
+	entry.used.Store(c.clock.Add(1))
+	c.layouts[layout] = entry
+	if len(c.layouts) > c.size {
+		c.evict()
+	}
+
+	return l
+}
+
+// evict removes the least recently used layout from the cache, the caller must hold the write
+// lock.
+func (c *layoutCache) evict() {
+	var oldest string
+	var oldestUsed int64
+	found := false
+	for layout, entry := range c.layouts {
+		if used := entry.used.Load(); !found || used < oldestUsed {
+			oldest, oldestUsed, found = layout, used, true
+		}
+	}
+
+	delete(c.layouts, oldest)
+}
+
+// getLayout returns the compiled layout from the default layout cache.
+func getLayout(layout string) *Layout {
+	return defaultLayoutCache.get(layout)
+}
@@ -0,0 +1,158 @@
+package date
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ghosind/go-assert"
+)
+
+func TestCompileLayout(t *testing.T) {
+	a := assert.New(t)
+
+	layout, err := CompileLayout("YYYY-MM-DD HH:mm:ss.SSS")
+	a.NilNow(err)
+	a.EqualNow(layout.String(), "YYYY-MM-DD HH:mm:ss.SSS")
+
+	tm := Date(2024, time.January, 10, 23, 59, 30, 123000000)
+	a.EqualNow(layout.Format(tm), "2024-01-10 23:59:30.123")
+	a.EqualNow(string(layout.AppendFormat([]byte("Time: "), tm)), "Time: 2024-01-10 23:59:30.123")
+
+	parsed, err := layout.Parse("2024-01-10 23:59:30.123")
+	a.NilNow(err)
+	a.TrueNow(parsed.Equal(Date(2024, time.January, 10, 23, 59, 30, 123000000, time.Local)))
+
+	_, err = layout.Parse("2024-01-10")
+	a.NotNilNow(err)
+
+	for _, str := range []string{"[YYYY", "YYYY [at", "YYYY\\", "[a\\]"} {
+		layout, err = CompileLayout(str)
+		a.EqualNow(err, ErrInvalidLayout)
+		a.NilNow(layout)
+	}
+
+	layout, err = CompileLayout("[[YYYY\\]] \\[MM] [] \\\\")
+	a.NilNow(err)
+	a.EqualNow(layout.Format(tm), "[YYYY] [01]  \\")
+}
+
+func TestMustCompileLayout(t *testing.T) {
+	a := assert.New(t)
+
+	layout := MustCompileLayout("YYYY-MM-DD")
+	a.EqualNow(layout.Format(Date(2024, time.January, 10, 0, 0, 0, 0)), "2024-01-10")
+
+	a.PanicOfNow(func() {
+		MustCompileLayout("[YYYY")
+	}, ErrInvalidLayout)
+}
+
+func TestLayoutCache(t *testing.T) {
+	a := assert.New(t)
+
+	cache := newLayoutCache(2, compileLayout)
+	l1 := cache.get("YYYY")
+	a.EqualNow(cache.get("YYYY"), l1)
+
+	cache.get("MM")
+	cache.get("YYYY")
+	cache.get("DD") // evicts "MM"
+	a.EqualNow(len(cache.layouts), 2)
+	a.EqualNow(cache.get("YYYY"), l1)
+	_, ok := cache.layouts["MM"]
+	a.NotTrueNow(ok)
+}
+
+func TestLayoutCacheConcurrently(t *testing.T) {
+	a := assert.New(t)
+
+	cache := newLayoutCache(4, compileLayout)
+	layouts := []string{"YYYY", "MM", "DD", "HH", "mm", "ss"}
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			for j := 0; j < 100; j++ {
+				layout := layouts[(i+j)%len(layouts)]
+				a.Equal(cache.get(layout).String(), layout)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	a.EqualNow(len(cache.layouts), 4)
+}
+
+func TestCompileLayoutConcurrently(t *testing.T) {
+	a := assert.New(t)
+
+	layout := MustCompileLayout("YYYY-MM-DD HH:mm:ss")
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			tm := Date(2024, time.January, i+1, i, 0, 0, 0, time.Local)
+			str := layout.Format(tm)
+			parsed, err := layout.Parse(str)
+			a.Nil(err)
+			a.True(parsed.Equal(tm))
+		}(i)
+	}
+	wg.Wait()
+}
+
+var benchmarkString string
+
+func BenchmarkFormat(b *testing.B) {
+	tm := Date(2024, time.January, 10, 23, 59, 30, 123000000)
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		benchmarkString = tm.Format("YYYY-MM-DD HH:mm:ss.SSS Z")
+	}
+}
+
+func BenchmarkLayoutFormat(b *testing.B) {
+	tm := Date(2024, time.January, 10, 23, 59, 30, 123000000)
+	layout := MustCompileLayout("YYYY-MM-DD HH:mm:ss.SSS Z")
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		benchmarkString = layout.Format(tm)
+	}
+}
+
+func BenchmarkLayoutAppendFormat(b *testing.B) {
+	tm := Date(2024, time.January, 10, 23, 59, 30, 123000000)
+	layout := MustCompileLayout("YYYY-MM-DD HH:mm:ss.SSS Z")
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		buf = layout.AppendFormat(buf[:0], tm)
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		Parse("YYYY-MM-DD HH:mm:ss.SSS Z", "2024-01-10 23:59:30.123 +08:00")
+	}
+}
+
+func BenchmarkLayoutParse(b *testing.B) {
+	layout := MustCompileLayout("YYYY-MM-DD HH:mm:ss.SSS Z")
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		layout.Parse("2024-01-10 23:59:30.123 +08:00")
+	}
+}
@@ -0,0 +1,193 @@
+package date
+
+import (
+	"sync"
+	"time"
+)
+
+// Locale holds the localized names and markers that are used by the name tokens of the layouts,
+// for example the month names of the "MMMM" token or the meridiem markers of the "A" token.
+type Locale struct {
+	// Name is the name of the locale, it's used as the key of the locale registry.
+	Name string
+	// Months is the list of the full month names, beginning at January. It uses the English names
+	// if it does not have all the 12 names.
+	Months []string
+	// MonthsShort is the list of the abbreviated month names, beginning at January. It uses the
+	// English names if it does not have all the 12 names.
+	MonthsShort []string
+	// Weekdays is the list of the full weekday names, beginning at Sunday. It uses the English names
+	// if it does not have all the 7 names.
+	Weekdays []string
+	// WeekdaysShort is the list of the abbreviated weekday names, beginning at Sunday. It uses the
+	// English names if it does not have all the 7 names.
+	WeekdaysShort []string
+	// Meridiems is the ante and post meridiem markers in upper case, it uses the English markers if
+	// any of them is empty.
+	Meridiems [2]string
+	// LowerMeridiems is the ante and post meridiem markers in lower case, it uses the English
+	// markers if any of them is empty.
+	LowerMeridiems [2]string
+	// Ordinal returns the ordinal suffix of the number, for example "st" of 1 in English. It's
+	// used by the ordinal tokens like "Do", and it uses the English suffixes if it's nil.
+	Ordinal func(num int) string
+	// RelativeTime is the phrases of the relative time, it uses the English phrases if it's empty.
+	RelativeTime RelativeTime
+	// Calendar is the layouts of the calendar time, it uses the English layouts for the empty
+	// layouts.
+	Calendar CalendarLayouts
+	// FirstDayOfWeek is the first day of the week of the locale, for example Sunday in the United
+	// States or Monday in most of Europe. It uses the default first day of week (see
+	// SetFirstDayOfWeek) if it's nil.
+	FirstDayOfWeek *time.Weekday
+}
+
+// englishMeridiems and englishLowerMeridiems are the English meridiem markers.
+var (
+	englishMeridiems      = [2]string{"AM", "PM"}
+	englishLowerMeridiems = [2]string{"am", "pm"}
+)
+
+// English is the default locale of the package. Its name lists are the copies of the internal
+// names, so changing them does not affect the fallback names of the other locales.
+var English = &Locale{
+	Name:           "en",
+	Months:         append([]string(nil), fullMonthNames...),
+	MonthsShort:    append([]string(nil), abbrMonthNames...),
+	Weekdays:       append([]string(nil), fullWeekdayNames...),
+	WeekdaysShort:  append([]string(nil), abbrWeekdayNames...),
+	Meridiems:      englishMeridiems,
+	LowerMeridiems: englishLowerMeridiems,
+	Ordinal:        englishOrdinal,
+	RelativeTime:   englishRelativeTime,
+	Calendar:       englishCalendarLayouts,
+	FirstDayOfWeek: &englishFirstDayOfWeek,
+}
+
+// englishFirstDayOfWeek is the first day of week of the English locale.
+var englishFirstDayOfWeek = time.Sunday
+
+var (
+	localesMutex sync.RWMutex
+	locales      = map[string]*Locale{
+		English.Name: English,
+	}
+)
+
+// RegisterLocale adds the locale into the registry, and it'll overwrite the locale that has the
+// same name. The locale may be partial, the fields that are empty or incomplete use the English
+// values.
+func RegisterLocale(locale *Locale) {
+	localesMutex.Lock()
+	defer localesMutex.Unlock()
+
+	locales[locale.Name] = locale
+}
+
+// LoadLocale returns the registered locale with the given name, or returns ErrUnknownLocale if
+// no locale has the name.
+func LoadLocale(name string) (*Locale, error) {
+	localesMutex.RLock()
+	defer localesMutex.RUnlock()
+
+	locale, ok := locales[name]
+	if !ok {
+		return nil, ErrUnknownLocale
+	}
+
+	return locale, nil
+}
+
+// getLocale returns the locale, or returns the default locale if it is nil.
+func getLocale(locale *Locale) *Locale {
+	if locale == nil {
+		return English
+	}
+	return locale
+}
+
+// ordinal returns the ordinal suffix of the number in the locale.
+func (l *Locale) ordinal(num int) string {
+	if l.Ordinal == nil {
+		return englishOrdinal(num)
+	}
+	return l.Ordinal(num)
+}
+
+// firstDayOfWeek returns the first day of week of the locale, or the default first day of week if
+// the locale does not set it.
+func (l *Locale) firstDayOfWeek() time.Weekday {
+	if l.FirstDayOfWeek == nil {
+		return FirstDayOfWeek()
+	}
+	return *l.FirstDayOfWeek
+}
+
+// months returns the full month names of the locale, or the English names if it does not have
+// all of them.
+func (l *Locale) months() []string {
+	return namesOr(l.Months, fullMonthNames)
+}
+
+// monthsShort returns the abbreviated month names of the locale, or the English names if it does
+// not have all of them.
+func (l *Locale) monthsShort() []string {
+	return namesOr(l.MonthsShort, abbrMonthNames)
+}
+
+// weekdays returns the full weekday names of the locale, or the English names if it does not have
+// all of them.
+func (l *Locale) weekdays() []string {
+	return namesOr(l.Weekdays, fullWeekdayNames)
+}
+
+// weekdaysShort returns the abbreviated weekday names of the locale, or the English names if it
+// does not have all of them.
+func (l *Locale) weekdaysShort() []string {
+	return namesOr(l.WeekdaysShort, abbrWeekdayNames)
+}
+
+// meridiems returns the meridiem markers of the locale in upper or lower case, or the English
+// markers if any of them is empty.
+func (l *Locale) meridiems(lower bool) [2]string {
+	markers, defaults := l.Meridiems, englishMeridiems
+	if lower {
+		markers, defaults = l.LowerMeridiems, englishLowerMeridiems
+	}
+
+	if markers[0] == "" || markers[1] == "" {
+		return defaults
+	}
+	return markers
+}
+
+// namesOr returns the names if it has as many names as the defaults, otherwise the defaults.
+func namesOr(names, defaults []string) []string {
+	if len(names) < len(defaults) {
+		return defaults
+	}
+	return names
+}
+
+// englishOrdinal returns the English ordinal suffix of the number.
+func englishOrdinal(num int) string {
+	if num < 0 {
+		num = -num
+	}
+
+	switch num % 100 {
+	case 11, 12, 13:
+		return "th"
+	}
+
+	switch num % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	default:
+		return "th"
+	}
+}
@@ -0,0 +1,116 @@
+package date_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ghosind/go-assert"
+	"github.com/ghosind/go-date"
+)
+
+var french = &date.Locale{
+	Name: "fr",
+	Months: []string{
+		"janvier", "février", "mars", "avril", "mai", "juin",
+		"juillet", "août", "septembre", "octobre", "novembre", "décembre",
+	},
+	MonthsShort: []string{
+		"janv.", "févr.", "mars", "avr.", "mai", "juin",
+		"juil.", "août", "sept.", "oct.", "nov.", "déc.",
+	},
+	Weekdays:       []string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+	WeekdaysShort:  []string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+	Meridiems:      [2]string{"AM", "PM"},
+	LowerMeridiems: [2]string{"am", "pm"},
+	Ordinal: func(num int) string {
+		if num == 1 {
+			return "er"
+		}
+		return "e"
+	},
+	FirstDayOfWeek: &monday,
+}
+
+var monday = time.Monday
+
+func TestLoadLocale(t *testing.T) {
+	a := assert.New(t)
+
+	locale, err := date.LoadLocale("en")
+	a.NilNow(err)
+	a.EqualNow(locale, date.English)
+
+	_, err = date.LoadLocale("fr")
+	a.EqualNow(err, date.ErrUnknownLocale)
+
+	date.RegisterLocale(french)
+	locale, err = date.LoadLocale("fr")
+	a.NilNow(err)
+	a.EqualNow(locale, french)
+}
+
+func TestFormatLocale(t *testing.T) {
+	a := assert.New(t)
+
+	tm := date.Date(2024, time.February, 5, 15, 4, 5, 0)
+
+	a.EqualNow(tm.FormatLocale("dddd D MMMM YYYY", french), "lundi 5 février 2024")
+	a.EqualNow(tm.FormatLocale("ddd D MMM YYYY", french), "lun. 5 févr. 2024")
+	a.EqualNow(tm.FormatLocale("dddd, MMMM D, YYYY h A", nil), "Monday, February 5, 2024 3 PM")
+	a.EqualNow(tm.FormatLocale("Do MMMM", french), "5e février")
+	a.EqualNow(date.Date(2024, time.February, 1, 0, 0, 0, 0).FormatLocale("Do MMMM", french),
+		"1er février")
+}
+
+func TestParseLocale(t *testing.T) {
+	a := assert.New(t)
+
+	tm, err := date.ParseLocale("dddd D MMMM YYYY", "lundi 5 février 2024", french)
+	a.NilNow(err)
+	a.TrueNow(tm.Equal(date.Date(2024, time.February, 5, 0, 0, 0, 0, time.Local)))
+
+	tm, err = date.ParseLocale("D MMM YYYY", "5 DÉC. 2024", french)
+	a.NilNow(err)
+	a.TrueNow(tm.Equal(date.Date(2024, time.December, 5, 0, 0, 0, 0, time.Local)))
+
+	_, err = date.ParseLocale("D MMMM YYYY", "5 February 2024", french)
+	a.NotNilNow(err)
+
+	tm, err = date.ParseLocale("ddd, D MMMM YYYY", "Mon, 5 February 2024", nil)
+	a.NilNow(err)
+	a.TrueNow(tm.Equal(date.Date(2024, time.February, 5, 0, 0, 0, 0, time.Local)))
+
+	tm, err = date.ParseLocale("Do MMMM YYYY", "1er février 2024", french)
+	a.NilNow(err)
+	a.TrueNow(tm.Equal(date.Date(2024, time.February, 1, 0, 0, 0, 0, time.Local)))
+
+	_, err = date.ParseLocale("Do MMMM YYYY", "1st février 2024", french)
+	a.NotNilNow(err)
+}
+
+func TestPartialLocale(t *testing.T) {
+	a := assert.New(t)
+
+	partial := &date.Locale{Name: "xx", Months: []string{"a"}}
+	tm := date.Date(2024, time.February, 5, 15, 4, 5, 0)
+
+	a.EqualNow(tm.FormatLocale("MMM MMMM dddd ddd A a", partial), "Feb February Monday Mon PM pm")
+
+	parsed, err := date.ParseLocale("MMMM D YYYY h A", "February 5 2024 3 PM", partial)
+	a.NilNow(err)
+	a.TrueNow(parsed.Equal(date.Date(2024, time.February, 5, 15, 0, 0, 0, time.Local)))
+}
+
+func TestEnglishLocaleCopy(t *testing.T) {
+	a := assert.New(t)
+
+	date.English.Months[1] = "Février"
+	defer func() {
+		date.English.Months[1] = "February"
+	}()
+
+	tm := date.Date(2024, time.February, 5, 0, 0, 0, 0)
+	a.EqualNow(tm.FormatLocale("MMMM", date.English), "Février")
+	// changing the English names does not affect the fallback names
+	a.EqualNow(tm.FormatLocale("MMMM", &date.Locale{Name: "xx"}), "February")
+}
@@ -0,0 +1,148 @@
+package date
+
+import (
+	"encoding/json"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// DefaultMarshalLayout is the default layout of marshaling and unmarshaling the time, it's the
+// same as time.RFC3339Nano.
+const DefaultMarshalLayout = "YYYY-MM-DD[T]HH:mm:ss.999999999Z07:00"
+
+// layoutTagName is the key of the struct tag that overrides the layout of a field.
+const layoutTagName = "date"
+
+var (
+	marshalLayoutMutex sync.RWMutex
+	marshalLayout      = DefaultMarshalLayout
+)
+
+// SetMarshalLayout sets the layout of marshaling and unmarshaling the time in JSON and text, it
+// resets the layout to DefaultMarshalLayout if the layout is empty.
+func SetMarshalLayout(layout string) {
+	marshalLayoutMutex.Lock()
+	defer marshalLayoutMutex.Unlock()
+
+	if layout == "" {
+		layout = DefaultMarshalLayout
+	}
+	marshalLayout = layout
+}
+
+// MarshalLayout returns the layout of marshaling and unmarshaling the time in JSON and text.
+func MarshalLayout() string {
+	marshalLayoutMutex.RLock()
+	defer marshalLayoutMutex.RUnlock()
+
+	return marshalLayout
+}
+
+// TagLayout returns the layout of the `date` struct tag, for example "YYYY-MM-DD" of
+// `date:"YYYY-MM-DD"`, or the marshal layout if the tag has no layout. It's used by the encoders
+// that support the layout of the fields with MarshalJSONLayout and UnmarshalJSONLayout, because
+// encoding/json does not pass the struct tags to the marshalers.
+func TagLayout(tag reflect.StructTag) string {
+	if layout, ok := tag.Lookup(layoutTagName); ok && layout != "" {
+		return layout
+	}
+	return MarshalLayout()
+}
+
+// MarshalJSON implements the json.Marshaler interface, the time is a quoted string in the marshal
+// layout.
+func (t Time) MarshalJSON() ([]byte, error) {
+	return t.MarshalJSONLayout(MarshalLayout())
+}
+
+// MarshalJSONLayout returns the time as a quoted JSON string in the layout. It returns
+// ErrYearRange if the year is outside of the range [0,9999].
+func (t Time) MarshalJSONLayout(layout string) ([]byte, error) {
+	if !isMarshalYear(t.Year()) {
+		return nil, ErrYearRange
+	}
+	return json.Marshal(t.Format(layout))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, the time is a quoted string in the
+// marshal layout. A null value is a no-op, and an empty string is the zero time.
+func (t *Time) UnmarshalJSON(data []byte) error {
+	return t.UnmarshalJSONLayout(data, MarshalLayout())
+}
+
+// UnmarshalJSONLayout parses the quoted JSON string in the layout. A null value is a no-op, and an
+// empty string is the zero time.
+func (t *Time) UnmarshalJSONLayout(data []byte, layout string) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+
+	return t.UnmarshalTextLayout([]byte(str), layout)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface, the time is formatted in the
+// marshal layout.
+func (t Time) MarshalText() ([]byte, error) {
+	return t.MarshalTextLayout(MarshalLayout())
+}
+
+// MarshalTextLayout returns the time formatted in the layout. It returns ErrYearRange if the year
+// is outside of the range [0,9999].
+func (t Time) MarshalTextLayout(layout string) ([]byte, error) {
+	if !isMarshalYear(t.Year()) {
+		return nil, ErrYearRange
+	}
+	buf := make([]byte, 0, 64)
+	return getLayout(layout).appendFormat(buf, t, English), nil
+}
+
+// isMarshalYear reports whether the year can be marshaled, the years outside of [0,9999] cannot be
+// parsed by the four-digit year of the layouts.
+func isMarshalYear(year int) bool {
+	return year >= 0 && year <= 9999
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface, the time is in the marshal
+// layout. An empty text is the zero time.
+func (t *Time) UnmarshalText(data []byte) error {
+	return t.UnmarshalTextLayout(data, MarshalLayout())
+}
+
+// UnmarshalTextLayout parses the text in the layout. An empty text is the zero time.
+func (t *Time) UnmarshalTextLayout(data []byte, layout string) error {
+	if len(data) == 0 {
+		*t = Time{}
+		return nil
+	}
+
+	tm, err := Parse(layout, string(data))
+	if err != nil {
+		return err
+	}
+	*t = tm
+
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface, it's the same as the binary
+// form of time.Time that keeps the time instant and the offset.
+func (t Time) MarshalBinary() ([]byte, error) {
+	return t.Time.MarshalBinary()
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (t *Time) UnmarshalBinary(data []byte) error {
+	var tm time.Time
+	if err := tm.UnmarshalBinary(data); err != nil {
+		return err
+	}
+	t.Time = tm
+
+	return nil
+}
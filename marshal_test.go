@@ -0,0 +1,163 @@
+package date_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ghosind/go-assert"
+	"github.com/ghosind/go-date"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	a := assert.New(t)
+	loc := time.FixedZone("UTC+8", 8*60*60)
+
+	type event struct {
+		Start date.Time  `json:"start"`
+		End   *date.Time `json:"end"`
+	}
+
+	tm := date.Date(2024, time.January, 10, 9, 30, 15, 123000000, loc)
+	data, err := json.Marshal(event{Start: tm})
+	a.NilNow(err)
+	a.EqualNow(string(data), `{"start":"2024-01-10T09:30:15.123+08:00","end":null}`)
+
+	expected, _ := json.Marshal(tm.Time)
+	data, err = json.Marshal(tm)
+	a.NilNow(err)
+	a.EqualNow(data, expected)
+
+	var ev event
+	a.NilNow(json.Unmarshal([]byte(`{"start":"2024-01-10T09:30:15.123+08:00","end":null}`), &ev))
+	a.TrueNow(ev.Start.Equal(tm))
+	a.NilNow(ev.End)
+
+	ev = event{Start: tm}
+	a.NilNow(json.Unmarshal([]byte(`{"start":null,"end":"2024-01-10T01:30:15Z"}`), &ev))
+	a.TrueNow(ev.Start.Equal(tm))
+	a.TrueNow(ev.End.Equal(date.Date(2024, time.January, 10, 1, 30, 15, 0)))
+
+	a.NilNow(json.Unmarshal([]byte(`{"start":""}`), &ev))
+	a.TrueNow(ev.Start.IsZero())
+
+	err = json.Unmarshal([]byte(`{"start":"2024-01-10"}`), &ev)
+	var pe *date.ParseError
+	a.TrueNow(errors.As(err, &pe))
+	a.TrueNow(errors.Is(err, date.ErrTooShort))
+
+	a.NotNilNow(json.Unmarshal([]byte(`{"start":20240110}`), &ev))
+
+	for _, year := range []int{-1, 10000} {
+		tm := date.Date(year, time.January, 10, 9, 30, 15, 0, time.UTC)
+		_, expectedErr := json.Marshal(tm.Time)
+		a.NotNilNow(expectedErr)
+		_, err = json.Marshal(tm)
+		a.TrueNow(errors.Is(err, date.ErrYearRange))
+		_, err = tm.MarshalJSONLayout("YYYY-MM-DD")
+		a.TrueNow(errors.Is(err, date.ErrYearRange))
+	}
+}
+
+func TestSetMarshalLayout(t *testing.T) {
+	a := assert.New(t)
+	defer date.SetMarshalLayout("")
+
+	a.EqualNow(date.MarshalLayout(), date.DefaultMarshalLayout)
+
+	date.SetMarshalLayout("YYYY-MM-DD HH:mm")
+	a.EqualNow(date.MarshalLayout(), "YYYY-MM-DD HH:mm")
+
+	tm := date.Date(2024, time.January, 10, 9, 30, 0, 0)
+	data, err := json.Marshal(tm)
+	a.NilNow(err)
+	a.EqualNow(string(data), `"2024-01-10 09:30"`)
+
+	var parsed date.Time
+	a.NilNow(json.Unmarshal([]byte(`"2024-01-10 09:30"`), &parsed))
+	a.EqualNow(parsed.Format("YYYY-MM-DD HH:mm"), "2024-01-10 09:30")
+
+	date.SetMarshalLayout("")
+	a.EqualNow(date.MarshalLayout(), date.DefaultMarshalLayout)
+}
+
+func TestTagLayout(t *testing.T) {
+	a := assert.New(t)
+
+	type record struct {
+		Day     date.Time `date:"YYYY-MM-DD"`
+		Created date.Time
+	}
+
+	typ := reflect.TypeOf(record{})
+	field, _ := typ.FieldByName("Day")
+	a.EqualNow(date.TagLayout(field.Tag), "YYYY-MM-DD")
+	field, _ = typ.FieldByName("Created")
+	a.EqualNow(date.TagLayout(field.Tag), date.DefaultMarshalLayout)
+
+	// an encoder that supports the layouts of the fields
+	rec := record{Day: date.Date(2024, time.January, 10, 9, 30, 0, 0)}
+	v := reflect.ValueOf(rec)
+	field = typ.Field(0)
+	data, err := v.Field(0).Interface().(date.Time).MarshalJSONLayout(date.TagLayout(field.Tag))
+	a.NilNow(err)
+	a.EqualNow(string(data), `"2024-01-10"`)
+
+	var day date.Time
+	a.NilNow(day.UnmarshalJSONLayout([]byte(`"2024-01-10"`), date.TagLayout(field.Tag)))
+	a.EqualNow(day.Format("YYYY-MM-DD"), "2024-01-10")
+}
+
+func TestMarshalText(t *testing.T) {
+	a := assert.New(t)
+
+	tm := date.Date(2024, time.January, 10, 9, 30, 15, 0)
+	data, err := tm.MarshalText()
+	a.NilNow(err)
+	a.EqualNow(string(data), "2024-01-10T09:30:15Z")
+
+	data, err = tm.MarshalTextLayout("DD/MM/YYYY")
+	a.NilNow(err)
+	a.EqualNow(string(data), "10/01/2024")
+
+	var parsed date.Time
+	a.NilNow(parsed.UnmarshalText([]byte("2024-01-10T09:30:15Z")))
+	a.TrueNow(parsed.Equal(tm))
+
+	a.NilNow(parsed.UnmarshalText(nil))
+	a.TrueNow(parsed.IsZero())
+
+	a.NotNilNow(parsed.UnmarshalText([]byte("2024/01/10")))
+
+	for _, year := range []int{-1, 10000} {
+		tm := date.Date(year, time.January, 10, 9, 30, 15, 0, time.UTC)
+		_, err = tm.MarshalText()
+		a.TrueNow(errors.Is(err, date.ErrYearRange))
+		_, err = tm.MarshalTextLayout("DD/MM/YYYY")
+		a.TrueNow(errors.Is(err, date.ErrYearRange))
+	}
+
+	m := map[date.Time]int{tm: 1}
+	data, err = json.Marshal(m)
+	a.NilNow(err)
+	a.EqualNow(string(data), `{"2024-01-10T09:30:15Z":1}`)
+}
+
+func TestMarshalBinary(t *testing.T) {
+	a := assert.New(t)
+	loc := time.FixedZone("UTC+8", 8*60*60)
+
+	tm := date.Date(2024, time.January, 10, 9, 30, 15, 123456789, loc)
+	data, err := tm.MarshalBinary()
+	a.NilNow(err)
+
+	var parsed date.Time
+	a.NilNow(parsed.UnmarshalBinary(data))
+	a.TrueNow(parsed.Equal(tm))
+	_, offset := parsed.Zone()
+	a.EqualNow(offset, 8*60*60)
+
+	a.NotNilNow(parsed.UnmarshalBinary([]byte{}))
+}
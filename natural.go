@@ -0,0 +1,500 @@
+package date
+
+import (
+	"strings"
+	"time"
+)
+
+// naturalUnit is the unit of the natural language expressions.
+type naturalUnit int
+
+const (
+	naturalUnitNone naturalUnit = iota
+	naturalUnitSecond
+	naturalUnitMinute
+	naturalUnitHour
+	naturalUnitDay
+	naturalUnitWeek
+	naturalUnitMonth
+	naturalUnitQuarter
+	naturalUnitYear
+)
+
+// naturalUnits is the names of the units, including the plural and the abbreviated names.
+var naturalUnits = map[string]naturalUnit{
+	"second":   naturalUnitSecond,
+	"seconds":  naturalUnitSecond,
+	"sec":      naturalUnitSecond,
+	"secs":     naturalUnitSecond,
+	"minute":   naturalUnitMinute,
+	"minutes":  naturalUnitMinute,
+	"min":      naturalUnitMinute,
+	"mins":     naturalUnitMinute,
+	"hour":     naturalUnitHour,
+	"hours":    naturalUnitHour,
+	"hr":       naturalUnitHour,
+	"hrs":      naturalUnitHour,
+	"day":      naturalUnitDay,
+	"days":     naturalUnitDay,
+	"week":     naturalUnitWeek,
+	"weeks":    naturalUnitWeek,
+	"month":    naturalUnitMonth,
+	"months":   naturalUnitMonth,
+	"quarter":  naturalUnitQuarter,
+	"quarters": naturalUnitQuarter,
+	"year":     naturalUnitYear,
+	"years":    naturalUnitYear,
+}
+
+// naturalModifiers is the number of the units to shift for the modifiers like "next" or "last".
+var naturalModifiers = map[string]int{
+	"next":     1,
+	"last":     -1,
+	"previous": -1,
+	"this":     0,
+}
+
+// naturalDays is the days from today of the day names.
+var naturalDays = map[string]int{
+	"today":     0,
+	"tomorrow":  1,
+	"yesterday": -1,
+}
+
+// naturalWord is a word of the natural language expression.
+type naturalWord struct {
+	// text is the word in lower case.
+	text string
+	// raw is the word in the expression.
+	raw string
+	// offset is the byte offset of the word in the expression.
+	offset int
+}
+
+// naturalParser is the state of parsing a natural language expression.
+type naturalParser struct {
+	expr  string
+	words []naturalWord
+	pos   int
+	tm    Time
+
+	hasClock       bool
+	hour, min, sec int
+}
+
+// ParseNatural parses the natural language expression relative to the reference time, and returns
+// the time it represents in the location of the reference time. The expression is a sequence of
+// the phrases, and the phrases are case-insensitive:
+//
+//   - "now", "today", "tomorrow", and "yesterday".
+//   - "in 3 days", "3 hours ago", "2 weeks later", and "an hour from now".
+//   - "next friday", "last monday", "this sunday", and "friday".
+//   - "next week", "last month", and "this year".
+//   - "start of next quarter", "beginning of the week", and "end of month".
+//   - "9am", "9:30 pm", "15:04", "noon", and "midnight", optionally preceded by "at".
+//
+// The dates like "tomorrow" or "next friday" are at the start of the day, unless a time of day is
+// given in the expression, for example "tomorrow 9am". It returns a *NaturalParseError with the
+// position of the first unrecognized word if the expression cannot be parsed.
+func ParseNatural(expr string, ref Time) (Time, error) {
+	p := &naturalParser{
+		expr:  expr,
+		words: splitNaturalWords(expr),
+		tm:    ref,
+	}
+	if len(p.words) == 0 {
+		return Time{}, p.fail()
+	}
+
+	for p.pos < len(p.words) {
+		if !p.parsePhrase() {
+			return Time{}, p.fail()
+		}
+	}
+
+	if p.hasClock {
+		y, m, d := p.tm.Date()
+		p.tm = Date(y, m, d, p.hour, p.min, p.sec, 0, p.tm.Location())
+	}
+
+	return p.tm, nil
+}
+
+// splitNaturalWords splits the expression into the lower-case words, the spaces and the commas are
+// the separators.
+func splitNaturalWords(expr string) []naturalWord {
+	words := make([]naturalWord, 0, 8)
+
+	for i := 0; i < len(expr); {
+		if c := expr[i]; c == ' ' || c == '\t' || c == '\n' || c == ',' {
+			i++
+			continue
+		}
+
+		j := i + 1
+		for ; j < len(expr); j++ {
+			if c := expr[j]; c == ' ' || c == '\t' || c == '\n' || c == ',' {
+				break
+			}
+		}
+		words = append(words, naturalWord{text: strings.ToLower(expr[i:j]), raw: expr[i:j], offset: i})
+		i = j
+	}
+
+	return words
+}
+
+// peek returns the word at the offset of the current position, or an empty string if it's out of
+// the expression.
+func (p *naturalParser) peek(offset int) string {
+	if p.pos+offset >= len(p.words) {
+		return ""
+	}
+	return p.words[p.pos+offset].text
+}
+
+// fail returns the error of the word at the current position.
+func (p *naturalParser) fail() error {
+	if p.pos >= len(p.words) {
+		return &NaturalParseError{Expr: p.expr, Offset: len(p.expr)}
+	}
+
+	word := p.words[p.pos]
+	return &NaturalParseError{
+		Expr:   p.expr,
+		Word:   word.raw,
+		Offset: word.offset,
+	}
+}
+
+// parsePhrase parses the phrase at the current position and applies it to the time. It returns
+// false and keeps the position at the unrecognized word if the phrase cannot be parsed.
+func (p *naturalParser) parsePhrase() bool {
+	switch word := p.peek(0); word {
+	case "now":
+		p.pos++
+		return true
+	case "today", "tomorrow", "yesterday":
+		p.tm = p.tm.StartOfDay().AddDate(0, 0, naturalDays[word])
+		p.pos++
+		return true
+	case "in":
+		p.pos++
+		n, unit, ok := p.parseDuration()
+		if !ok {
+			return false
+		}
+		p.tm = shiftNatural(p.tm, unit, n)
+		return true
+	case "next", "last", "previous", "this":
+		return p.parseRelative()
+	case "start", "beginning", "end":
+		return p.parseBoundary()
+	case "on":
+		p.pos++
+		return p.parseWeekday(0)
+	case "at":
+		p.pos++
+		return p.parseClock()
+	}
+
+	if p.parseWeekday(0) || p.parseClock() {
+		return true
+	}
+
+	n, unit, ok := p.parseDuration()
+	if !ok {
+		return false
+	}
+	switch p.peek(0) {
+	case "ago":
+		n = -n
+	case "later", "hence":
+	case "from":
+		if p.peek(1) != "now" {
+			p.pos++
+			return false
+		}
+		p.pos++
+	default:
+		return false
+	}
+	p.pos++
+	p.tm = shiftNatural(p.tm, unit, n)
+
+	return true
+}
+
+// parseDuration parses the amount and the unit like "3 days" or "an hour".
+func (p *naturalParser) parseDuration() (int, naturalUnit, bool) {
+	var n int
+	switch word := p.peek(0); word {
+	case "a", "an", "one":
+		n = 1
+	default:
+		if word == "" || !isNaturalNumber(word) {
+			return 0, naturalUnitNone, false
+		}
+		n = atoi(word)
+	}
+
+	unit, ok := naturalUnits[p.peek(1)]
+	if !ok {
+		p.pos++
+		return 0, naturalUnitNone, false
+	}
+	p.pos += 2
+
+	return n, unit, true
+}
+
+// parseRelative parses the phrases like "next friday" or "last month".
+func (p *naturalParser) parseRelative() bool {
+	n := naturalModifiers[p.peek(0)]
+	p.pos++
+
+	if p.parseWeekday(n) {
+		return true
+	}
+
+	unit, ok := naturalUnits[p.peek(0)]
+	if !ok {
+		return false
+	}
+	p.pos++
+	p.tm = shiftNatural(p.tm, unit, n)
+
+	return true
+}
+
+// parseBoundary parses the phrases like "start of next quarter" or "end of month".
+func (p *naturalParser) parseBoundary() bool {
+	start := p.peek(0) != "end"
+	p.pos++
+	if p.peek(0) != "of" {
+		return false
+	}
+	p.pos++
+	if p.peek(0) == "the" {
+		p.pos++
+	}
+
+	n, ok := naturalModifiers[p.peek(0)]
+	if ok {
+		p.pos++
+	}
+
+	unit, ok := naturalUnits[p.peek(0)]
+	if !ok {
+		return false
+	}
+	p.pos++
+
+	// shifts from the start of the unit to avoid the overflow of the days of month
+	tm := shiftNatural(boundaryNatural(p.tm, unit, true), unit, n)
+	p.tm = boundaryNatural(tm, unit, start)
+
+	return true
+}
+
+// parseWeekday parses the weekday names, and moves the date to the weekday. The direction is 1
+// for the next weekday, -1 for the last weekday, and 0 for the upcoming weekday (or the weekday
+// in the current week for "this").
+func (p *naturalParser) parseWeekday(direction int) bool {
+	wd, ok := lookupNaturalWeekday(p.peek(0))
+	if !ok {
+		return false
+	}
+	isThis := p.pos > 0 && p.words[p.pos-1].text == "this"
+	p.pos++
+
+	cur := p.tm.Weekday()
+	var days int
+	switch {
+	case direction > 0:
+		days = daysSinceWeekday(wd, cur)
+		if days == 0 {
+			days = 7
+		}
+	case direction < 0:
+		days = -daysSinceWeekday(cur, wd)
+		if days == 0 {
+			days = -7
+		}
+	case isThis:
+		days = daysSinceWeekday(wd, FirstDayOfWeek()) - daysSinceWeekday(cur, FirstDayOfWeek())
+	default:
+		days = daysSinceWeekday(wd, cur)
+	}
+	p.tm = p.tm.StartOfDay().AddDate(0, 0, days)
+
+	return true
+}
+
+// parseClock parses the time of day like "9am", "9:30 pm", "15:04", "noon", or "midnight".
+func (p *naturalParser) parseClock() bool {
+	word := p.peek(0)
+	switch word {
+	case "noon", "midday":
+		p.setClock(12, 0, 0)
+		p.pos++
+		return true
+	case "midnight":
+		p.setClock(0, 0, 0)
+		p.pos++
+		return true
+	}
+
+	meridiem := ""
+	if strings.HasSuffix(word, "am") || strings.HasSuffix(word, "pm") {
+		word, meridiem = word[:len(word)-2], word[len(word)-2:]
+	} else if next := p.peek(1); next == "am" || next == "pm" {
+		meridiem = next
+	}
+
+	parts := strings.Split(word, ":")
+	if len(parts) > 3 || (len(parts) == 1 && meridiem == "") {
+		return false
+	}
+	clock := [3]int{}
+	for i, part := range parts {
+		if !isNaturalNumber(part) || len(part) > 2 || (i > 0 && len(part) != 2) {
+			return false
+		}
+		clock[i] = atoi(part)
+	}
+	hour, min, sec := clock[0], clock[1], clock[2]
+	if min > 59 || sec > 59 {
+		return false
+	}
+
+	switch meridiem {
+	case "":
+		if hour > 23 {
+			return false
+		}
+	default:
+		if hour < 1 || hour > 12 {
+			return false
+		}
+		hour %= 12
+		if meridiem == "pm" {
+			hour += 12
+		}
+	}
+
+	p.setClock(hour, min, sec)
+	p.pos++
+	if meridiem != "" && p.peek(0) == meridiem {
+		p.pos++
+	}
+
+	return true
+}
+
+// setClock sets the time of day, it's applied after all the phrases.
+func (p *naturalParser) setClock(hour, min, sec int) {
+	p.hasClock, p.hour, p.min, p.sec = true, hour, min, sec
+}
+
+// shiftNatural adds the number of the units to the time. The days of month are clamped to the last
+// day of the month when shifting months, quarters, or years, for example one month after
+// January 31 is the last day of February.
+func shiftNatural(t Time, unit naturalUnit, n int) Time {
+	switch unit {
+	case naturalUnitSecond:
+		return t.Add(time.Duration(n) * time.Second)
+	case naturalUnitMinute:
+		return t.Add(time.Duration(n) * time.Minute)
+	case naturalUnitHour:
+		return t.Add(time.Duration(n) * time.Hour)
+	case naturalUnitDay:
+		return t.AddDate(0, 0, n)
+	case naturalUnitWeek:
+		return t.AddDate(0, 0, n*7)
+	case naturalUnitMonth, naturalUnitQuarter, naturalUnitYear:
+		months := n
+		if unit == naturalUnitQuarter {
+			months *= 3
+		} else if unit == naturalUnitYear {
+			months *= 12
+		}
+
+		y, m, d := t.Date()
+		ny, nm, nd := addMonths(y, m, d, months)
+		return t.AddDate(ny-y, int(nm-m), nd-d)
+	default:
+		return t
+	}
+}
+
+// boundaryNatural returns the start or the end of the unit of the time.
+func boundaryNatural(t Time, unit naturalUnit, start bool) Time {
+	switch unit {
+	case naturalUnitSecond:
+		if start {
+			return t.StartOfSecond()
+		}
+		return t.EndOfSecond()
+	case naturalUnitMinute:
+		if start {
+			return t.StartOfMinute()
+		}
+		return t.EndOfMinute()
+	case naturalUnitHour:
+		if start {
+			return t.StartOfHour()
+		}
+		return t.EndOfHour()
+	case naturalUnitDay:
+		if start {
+			return t.StartOfDay()
+		}
+		return t.EndOfDay()
+	case naturalUnitWeek:
+		if start {
+			return t.StartOfWeek()
+		}
+		return t.EndOfWeek()
+	case naturalUnitMonth:
+		if start {
+			return t.StartOfMonth()
+		}
+		return t.EndOfMonth()
+	case naturalUnitQuarter:
+		if start {
+			return t.StartOfQuarter()
+		}
+		return t.EndOfQuarter()
+	case naturalUnitYear:
+		if start {
+			return t.StartOfYear()
+		}
+		return t.EndOfYear()
+	default:
+		return t
+	}
+}
+
+// lookupNaturalWeekday returns the weekday of the full or the abbreviated weekday name.
+func lookupNaturalWeekday(word string) (time.Weekday, bool) {
+	for i := range fullWeekdayNames {
+		if strings.EqualFold(word, fullWeekdayNames[i]) || strings.EqualFold(word, abbrWeekdayNames[i]) {
+			return time.Weekday(i), true
+		}
+	}
+	return time.Sunday, false
+}
+
+// isNaturalNumber reports whether the word is a non-empty number that has at most 9 digits.
+func isNaturalNumber(word string) bool {
+	if len(word) == 0 || len(word) > 9 {
+		return false
+	}
+	for i := 0; i < len(word); i++ {
+		if !isDigit(word[i]) {
+			return false
+		}
+	}
+	return true
+}
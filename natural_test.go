@@ -0,0 +1,111 @@
+package date_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ghosind/go-assert"
+	"github.com/ghosind/go-date"
+)
+
+func TestParseNatural(t *testing.T) {
+	a := assert.New(t)
+	loc := time.FixedZone("UTC+8", 8*60*60)
+	// Wednesday
+	ref := date.Date(2024, time.January, 31, 14, 30, 15, 0, loc)
+
+	cases := []struct {
+		expr   string
+		expect time.Time
+	}{
+		{"now", time.Date(2024, 1, 31, 14, 30, 15, 0, loc)},
+		{"Today", time.Date(2024, 1, 31, 0, 0, 0, 0, loc)},
+		{"tomorrow", time.Date(2024, 2, 1, 0, 0, 0, 0, loc)},
+		{"yesterday", time.Date(2024, 1, 30, 0, 0, 0, 0, loc)},
+		{"tomorrow 9am", time.Date(2024, 2, 1, 9, 0, 0, 0, loc)},
+		{"tomorrow at 9:30 pm", time.Date(2024, 2, 1, 21, 30, 0, 0, loc)},
+		{"9am tomorrow", time.Date(2024, 2, 1, 9, 0, 0, 0, loc)},
+		{"today at noon", time.Date(2024, 1, 31, 12, 0, 0, 0, loc)},
+		{"yesterday midnight", time.Date(2024, 1, 30, 0, 0, 0, 0, loc)},
+		{"at 18:45:30", time.Date(2024, 1, 31, 18, 45, 30, 0, loc)},
+		{"12am", time.Date(2024, 1, 31, 0, 0, 0, 0, loc)},
+		{"3 hours ago", time.Date(2024, 1, 31, 11, 30, 15, 0, loc)},
+		{"in 10 minutes", time.Date(2024, 1, 31, 14, 40, 15, 0, loc)},
+		{"an hour from now", time.Date(2024, 1, 31, 15, 30, 15, 0, loc)},
+		{"2 weeks later", time.Date(2024, 2, 14, 14, 30, 15, 0, loc)},
+		{"in 1 month", time.Date(2024, 2, 29, 14, 30, 15, 0, loc)},
+		{"a year ago", time.Date(2023, 1, 31, 14, 30, 15, 0, loc)},
+		{"next friday", time.Date(2024, 2, 2, 0, 0, 0, 0, loc)},
+		{"next wednesday", time.Date(2024, 2, 7, 0, 0, 0, 0, loc)},
+		{"last friday", time.Date(2024, 1, 26, 0, 0, 0, 0, loc)},
+		{"last Wed", time.Date(2024, 1, 24, 0, 0, 0, 0, loc)},
+		{"this monday", time.Date(2024, 1, 29, 0, 0, 0, 0, loc)},
+		{"on sunday", time.Date(2024, 2, 4, 0, 0, 0, 0, loc)},
+		{"wednesday 8pm", time.Date(2024, 1, 31, 20, 0, 0, 0, loc)},
+		{"next week", time.Date(2024, 2, 7, 14, 30, 15, 0, loc)},
+		{"next month", time.Date(2024, 2, 29, 14, 30, 15, 0, loc)},
+		{"last quarter", time.Date(2023, 10, 31, 14, 30, 15, 0, loc)},
+		{"start of next quarter", time.Date(2024, 4, 1, 0, 0, 0, 0, loc)},
+		{"end of month", time.Date(2024, 1, 31, 23, 59, 59, 999999999, loc)},
+		{"end of next month", time.Date(2024, 2, 29, 23, 59, 59, 999999999, loc)},
+		{"beginning of the week", time.Date(2024, 1, 29, 0, 0, 0, 0, loc)},
+		{"start of last year", time.Date(2023, 1, 1, 0, 0, 0, 0, loc)},
+		{"end of the day", time.Date(2024, 1, 31, 23, 59, 59, 999999999, loc)},
+		{"start of day, in 2 days", time.Date(2024, 2, 2, 0, 0, 0, 0, loc)},
+	}
+
+	for _, c := range cases {
+		tm, err := date.ParseNatural(c.expr, ref)
+		a.NilNow(err, c.expr)
+		a.TrueNow(tm.Equal(date.Time{Time: c.expect}), c.expr, tm)
+		a.EqualNow(tm.Location(), loc, c.expr)
+	}
+}
+
+func TestParseNaturalError(t *testing.T) {
+	a := assert.New(t)
+	ref := date.Date(2024, time.January, 31, 14, 30, 15, 0, time.UTC)
+
+	cases := []struct {
+		expr   string
+		word   string
+		offset int
+		msg    string
+	}{
+		{"", "", 0, `parsing natural time "": unexpected end of expression at offset 0`},
+		{
+			"tomorrow at 9 o'clock", "9", 12,
+			`parsing natural time "tomorrow at 9 o'clock": unrecognized word "9" at offset 12`,
+		},
+		{
+			"in 3 Fortnights", "Fortnights", 5,
+			`parsing natural time "in 3 Fortnights": unrecognized word "Fortnights" at offset 5`,
+		},
+		{"3 hours", "", 7, `parsing natural time "3 hours": unexpected end of expression at offset 7`},
+		{"next", "", 4, `parsing natural time "next": unexpected end of expression at offset 4`},
+		{
+			"end of the decade", "decade", 11,
+			`parsing natural time "end of the decade": unrecognized word "decade" at offset 11`,
+		},
+		{
+			"start next month", "next", 6,
+			`parsing natural time "start next month": unrecognized word "next" at offset 6`,
+		},
+		{
+			"13pm", "13pm", 0,
+			`parsing natural time "13pm": unrecognized word "13pm" at offset 0`,
+		},
+	}
+
+	for _, c := range cases {
+		_, err := date.ParseNatural(c.expr, ref)
+		a.NotNilNow(err, c.expr)
+
+		var ne *date.NaturalParseError
+		a.TrueNow(errors.As(err, &ne), c.expr)
+		a.EqualNow(ne.Word, c.word, c.expr)
+		a.EqualNow(ne.Offset, c.offset, c.expr)
+		a.EqualNow(err.Error(), c.msg, c.expr)
+	}
+}
@@ -0,0 +1,158 @@
+package date
+
+import (
+	"database/sql/driver"
+)
+
+// NullTime represents a Time that may be null like sql.NullTime, the time is valid if Valid is
+// true. The invalid NullTime is null in JSON, an empty text, and NULL in the databases.
+type NullTime struct {
+	Time
+	Valid bool
+}
+
+// NewNullTime returns a valid NullTime of the time.
+func NewNullTime(t Time) NullTime {
+	return NullTime{Time: t, Valid: true}
+}
+
+// NullTimeFromPtr returns a valid NullTime of the time that the pointer points to, or an invalid
+// NullTime if the pointer is nil.
+func NullTimeFromPtr(t *Time) NullTime {
+	if t == nil {
+		return NullTime{}
+	}
+	return NewNullTime(*t)
+}
+
+// Ptr returns a pointer to the time, or nil if the NullTime is invalid.
+func (nt NullTime) Ptr() *Time {
+	if !nt.Valid {
+		return nil
+	}
+	return &nt.Time
+}
+
+// ValueOr returns the time if the NullTime is valid, or the default time.
+func (nt NullTime) ValueOr(def Time) Time {
+	if !nt.Valid {
+		return def
+	}
+	return nt.Time
+}
+
+// Format returns the time formatted by the layout, or an empty string if the NullTime is invalid.
+func (nt NullTime) Format(layout string) string {
+	return nt.FormatOr(layout, "")
+}
+
+// FormatOr returns the time formatted by the layout, or the placeholder if the NullTime is
+// invalid, for example "—".
+func (nt NullTime) FormatOr(layout, placeholder string) string {
+	if !nt.Valid {
+		return placeholder
+	}
+	return nt.Time.Format(layout)
+}
+
+// String returns the string of the time like time.Time.String, or "<null>" if the NullTime is
+// invalid.
+func (nt NullTime) String() string {
+	if !nt.Valid {
+		return "<null>"
+	}
+	return nt.Time.String()
+}
+
+// MarshalJSON implements the json.Marshaler interface, the invalid NullTime is null.
+func (nt NullTime) MarshalJSON() ([]byte, error) {
+	return nt.MarshalJSONLayout(MarshalLayout())
+}
+
+// MarshalJSONLayout returns the time as a quoted JSON string in the layout, or null if the
+// NullTime is invalid.
+func (nt NullTime) MarshalJSONLayout(layout string) ([]byte, error) {
+	if !nt.Valid {
+		return []byte("null"), nil
+	}
+	return nt.Time.MarshalJSONLayout(layout)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, the NullTime is invalid if the value is
+// null or an empty string.
+func (nt *NullTime) UnmarshalJSON(data []byte) error {
+	return nt.UnmarshalJSONLayout(data, MarshalLayout())
+}
+
+// UnmarshalJSONLayout parses the quoted JSON string in the layout, the NullTime is invalid if the
+// value is null or an empty string.
+func (nt *NullTime) UnmarshalJSONLayout(data []byte, layout string) error {
+	if string(data) == "null" {
+		nt.Time, nt.Valid = Time{}, false
+		return nil
+	}
+
+	var tm Time
+	if err := tm.UnmarshalJSONLayout(data, layout); err != nil {
+		return err
+	}
+	nt.Time, nt.Valid = tm, string(data) != `""`
+
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface, the invalid NullTime is an empty
+// text.
+func (nt NullTime) MarshalText() ([]byte, error) {
+	return nt.MarshalTextLayout(MarshalLayout())
+}
+
+// MarshalTextLayout returns the time formatted in the layout, or an empty text if the NullTime is
+// invalid.
+func (nt NullTime) MarshalTextLayout(layout string) ([]byte, error) {
+	if !nt.Valid {
+		return []byte{}, nil
+	}
+	return nt.Time.MarshalTextLayout(layout)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface, the NullTime is invalid if the
+// text is empty.
+func (nt *NullTime) UnmarshalText(data []byte) error {
+	return nt.UnmarshalTextLayout(data, MarshalLayout())
+}
+
+// UnmarshalTextLayout parses the text in the layout, the NullTime is invalid if the text is empty.
+func (nt *NullTime) UnmarshalTextLayout(data []byte, layout string) error {
+	var tm Time
+	if err := tm.UnmarshalTextLayout(data, layout); err != nil {
+		return err
+	}
+	nt.Time, nt.Valid = tm, len(data) > 0
+
+	return nil
+}
+
+// Scan implements the sql.Scanner interface, the NullTime is invalid if the value is nil.
+func (nt *NullTime) Scan(src any) error {
+	if src == nil {
+		nt.Time, nt.Valid = Time{}, false
+		return nil
+	}
+
+	if err := nt.Time.Scan(src); err != nil {
+		nt.Valid = false
+		return err
+	}
+	nt.Valid = true
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface, the value is nil if the NullTime is invalid.
+func (nt NullTime) Value() (driver.Value, error) {
+	if !nt.Valid {
+		return nil, nil
+	}
+	return nt.Time.Time, nil
+}
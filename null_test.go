@@ -0,0 +1,110 @@
+package date_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ghosind/go-assert"
+	"github.com/ghosind/go-date"
+)
+
+func TestNullTime(t *testing.T) {
+	a := assert.New(t)
+
+	tm := date.Date(2024, time.January, 10, 9, 30, 15, 0)
+
+	nt := date.NewNullTime(tm)
+	a.TrueNow(nt.Valid)
+	a.TrueNow(nt.Equal(tm))
+	a.EqualNow(*nt.Ptr(), tm)
+	a.EqualNow(nt.ValueOr(date.Time{}), tm)
+
+	a.TrueNow(date.NullTimeFromPtr(&tm).Valid)
+	a.NotTrueNow(date.NullTimeFromPtr(nil).Valid)
+
+	var null date.NullTime
+	a.NilNow(null.Ptr())
+	a.EqualNow(null.ValueOr(tm), tm)
+}
+
+func TestNullTimeFormat(t *testing.T) {
+	a := assert.New(t)
+
+	nt := date.NewNullTime(date.Date(2024, time.January, 10, 9, 30, 15, 0))
+	a.EqualNow(nt.Format("YYYY-MM-DD"), "2024-01-10")
+	a.EqualNow(nt.FormatOr("YYYY-MM-DD", "—"), "2024-01-10")
+	a.EqualNow(nt.String(), "2024-01-10 09:30:15 +0000 UTC")
+
+	var null date.NullTime
+	a.EqualNow(null.Format("YYYY-MM-DD"), "")
+	a.EqualNow(null.FormatOr("YYYY-MM-DD", "—"), "—")
+	a.EqualNow(null.String(), "<null>")
+}
+
+func TestNullTimeJSON(t *testing.T) {
+	a := assert.New(t)
+
+	type user struct {
+		Name    string        `json:"name"`
+		Deleted date.NullTime `json:"deleted"`
+	}
+
+	tm := date.Date(2024, time.January, 10, 9, 30, 15, 0)
+	data, err := json.Marshal(user{Name: "a", Deleted: date.NewNullTime(tm)})
+	a.NilNow(err)
+	a.EqualNow(string(data), `{"name":"a","deleted":"2024-01-10T09:30:15Z"}`)
+
+	data, err = json.Marshal(user{Name: "a"})
+	a.NilNow(err)
+	a.EqualNow(string(data), `{"name":"a","deleted":null}`)
+
+	var u user
+	a.NilNow(json.Unmarshal([]byte(`{"deleted":"2024-01-10T09:30:15Z"}`), &u))
+	a.TrueNow(u.Deleted.Valid)
+	a.TrueNow(u.Deleted.Equal(tm))
+
+	a.NilNow(json.Unmarshal([]byte(`{"deleted":null}`), &u))
+	a.NotTrueNow(u.Deleted.Valid)
+	a.TrueNow(u.Deleted.IsZero())
+
+	u.Deleted = date.NewNullTime(tm)
+	a.NilNow(json.Unmarshal([]byte(`{"deleted":""}`), &u))
+	a.NotTrueNow(u.Deleted.Valid)
+
+	u.Deleted = date.NewNullTime(tm)
+	a.NotNilNow(json.Unmarshal([]byte(`{"deleted":"2024/01/10"}`), &u))
+	a.TrueNow(u.Deleted.Valid)
+
+	data, err = date.NullTime{}.MarshalJSONLayout("YYYY-MM-DD")
+	a.NilNow(err)
+	a.EqualNow(string(data), "null")
+	data, err = date.NewNullTime(tm).MarshalJSONLayout("YYYY-MM-DD")
+	a.NilNow(err)
+	a.EqualNow(string(data), `"2024-01-10"`)
+}
+
+func TestNullTimeText(t *testing.T) {
+	a := assert.New(t)
+
+	tm := date.Date(2024, time.January, 10, 9, 30, 15, 0)
+	data, err := date.NewNullTime(tm).MarshalText()
+	a.NilNow(err)
+	a.EqualNow(string(data), "2024-01-10T09:30:15Z")
+
+	data, err = date.NullTime{}.MarshalText()
+	a.NilNow(err)
+	a.EqualNow(string(data), "")
+
+	var nt date.NullTime
+	a.NilNow(nt.UnmarshalText([]byte("2024-01-10T09:30:15Z")))
+	a.TrueNow(nt.Valid)
+	a.TrueNow(nt.Equal(tm))
+
+	a.NilNow(nt.UnmarshalText([]byte{}))
+	a.NotTrueNow(nt.Valid)
+
+	a.NilNow(nt.UnmarshalTextLayout([]byte("10/01/2024"), "DD/MM/YYYY"))
+	a.TrueNow(nt.Valid)
+	a.EqualNow(nt.Format("YYYY-MM-DD"), "2024-01-10")
+}
@@ -2,20 +2,58 @@ package date
 
 import (
 	"errors"
+	"strconv"
+	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 )
 
 var errParse error = errors.New("parse error") // a temporary error for parse
 
+// parseOptions is the options of parsing a formatted string.
+type parseOptions struct {
+	// loc is the location of the time if the value has no timezone offset.
+	loc *time.Location
+	// locale is the locale of the name tokens.
+	locale *Locale
+	// strict indicates to reject the out-of-range values instead of normalizing them.
+	strict bool
+}
+
 // Parse parses a formatted string with the layout and returns the time value it represents.
 func Parse(layout, value string) (Time, error) {
-	return parse(layout, value, time.Local)
+	return getLayout(layout).parse(value, parseOptions{loc: time.Local, locale: English})
+}
+
+// ParseStrict is like Parse but rejects the values that are out of range instead of normalizing
+// them, for example "2024-02-31" or "13:75". It also checks the parsed day of week against the
+// date, and rejects the text after the end of the layout. The returned error is a *ParseError that
+// describes the failing field and its range.
+func ParseStrict(layout, value string) (Time, error) {
+	return getLayout(layout).parse(value, parseOptions{
+		loc:    time.Local,
+		locale: English,
+		strict: true,
+	})
+}
+
+// ParseStrictInLocation is like ParseInLocation but rejects the values that are out of range
+// instead of normalizing them.
+func ParseStrictInLocation(layout, value string, loc *time.Location) (Time, error) {
+	return getLayout(layout).parse(value, parseOptions{loc: loc, locale: English, strict: true})
+}
+
+// ParseLocale is like Parse but uses the names and the markers of the locale for the name tokens.
+// It uses the default locale (English) if the locale is nil.
+func ParseLocale(layout, value string, locale *Locale) (Time, error) {
+	return getLayout(layout).parse(value, parseOptions{loc: time.Local, locale: getLocale(locale)})
 }
 
 // ParseInLocation parses a formatted string with the layout and the given location, and returns
 // the time value it represents.
 func ParseInLocation(layout, value string, loc *time.Location) (Time, error) {
-	return parse(layout, value, loc)
+	return getLayout(layout).parse(value, parseOptions{loc: loc, locale: English})
 }
 
 // ParseInLocationName tries to load the location with the given name, parses a formatted string
@@ -26,63 +64,110 @@ func ParseInLocationName(layout, value, name string) (Time, error) {
 		return Time{}, err
 	}
 
-	return parse(layout, value, loc)
+	return getLayout(layout).parse(value, parseOptions{loc: loc, locale: English})
+}
+
+// ParseMulti parses the value with the layouts in order, and returns the time and the first layout
+// that parses the value. If none of the layouts parses the value, it returns a *MultiParseError
+// that wraps the *ParseError of each layout.
+func ParseMulti(layouts []string, value string) (Time, string, error) {
+	return ParseMultiInLocation(layouts, value, time.Local)
+}
+
+// ParseMultiInLocation is like ParseMulti but uses the location for the time without time zone
+// information.
+func ParseMultiInLocation(layouts []string, value string, loc *time.Location) (Time, string, error) {
+	errs := make([]error, 0, len(layouts))
+
+	for _, layout := range layouts {
+		tm, err := getLayout(layout).parse(value, parseOptions{loc: loc, locale: English})
+		if err == nil {
+			return tm, layout, nil
+		}
+		errs = append(errs, err)
+	}
+
+	return Time{}, "", &MultiParseError{Value: value, Errors: errs}
 }
 
-func parse(layout, value string, loc *time.Location) (Time, error) {
-	oLayout, oValue := layout, value
+// parse parses the value by the compiled layout, and returns the time value it represents.
+func (l *Layout) parse(value string, opts parseOptions) (Time, error) {
+	oLayout, oValue := l.layout, value
+	locale := opts.locale
 	am := false
 	pm := false
-	var str string
 	var err error
+	var dayElem, weekdayElem, ydayElem, isoWeekElem, quarterElem, zoneElem parseElem
+	var weekFirst time.Weekday
 
 	var (
 		year     int
 		month    int = 1
+		hasMonth bool
 		day      int = 1
 		hour     int
 		min      int
 		sec      int
 		nsec     int
-		tzOffset int = -1
+		weekday  int = -1
+		yday     int = -1
+		isoYear  int
+		isoWeek  int = -1
+		isoWday  int = -1
+		quarter  int = -1
+		week     int = -1
+		century  int = -1
+		yy       int = -1
+		unixSec  int64
+		unixNsec int64
+		unixNeg  bool
+		hasUnix  bool
+		hasISOYr bool
+		tzOffset int
+		hasTZ    bool
+		zoneAbbr string
+		zoneLoc  *time.Location
 	)
 
-	for {
-		token, s, suffix := nextLayoutToken(layout)
-		if token == layoutTokenEnd {
-			break
+	for i, tok := range l.tokens {
+		token, s := tok.kind, tok.value
+		var tzHr, tzMm int
+
+		if tok.pad == padSpace {
+			value = strings.TrimLeft(value, " ")
 		}
-		layout = suffix
+		prev := value
 
 		switch token {
 		case layoutTokenYearLong:
 			year, value, err = readNum(value, 4, true)
 		case layoutTokenYear:
-			year, value, err = readNum(value, 2, true)
-			if err != nil {
-				break
-			}
-			if year > 69 {
-				year += 1900
-			} else {
-				year += 2000
-			}
+			yy, value, err = readNum(value, 2, true)
+			year = twoDigitYear(yy)
 		case layoutTokenMonth:
 			month, value, err = readNum(value, 2, false)
+			hasMonth = true
 		case layoutTokenMonthLong:
 			month, value, err = readNum(value, 2, true)
+			hasMonth = true
 		case layoutTokenMonthAbbr:
-			month, value, err = lookup(abbrMonthNames, value)
+			month, value, err = lookup(locale.monthsShort(), value)
 			if err != nil {
 				break
 			}
-			month++
+			month, hasMonth = month+1, true
 		case layoutTokenMonthFull:
-			month, value, err = lookup(fullMonthNames, value)
+			month, value, err = lookup(locale.months(), value)
 			if err != nil {
 				break
 			}
-			month++
+			month, hasMonth = month+1, true
+		case layoutTokenDayOfWeek:
+			weekday, value, err = readNum(value, 1, true)
+		case layoutTokenDayOfWeekAbbr:
+			weekday, value, err = lookup(locale.weekdaysShort(), value)
+		case layoutTokenDayOfWeekFull:
+			weekday, value, err = lookup(locale.weekdays(), value)
 		case layoutTokenDay:
 			day, value, err = readNum(value, 2, false)
 		case layoutTokenDayLong:
@@ -95,104 +180,312 @@ func parse(layout, value string, loc *time.Location) (Time, error) {
 			hour, value, err = readNum(value, 2, false)
 		case layoutTokenHour12Long:
 			hour, value, err = readNum(value, 2, true)
+		case layoutTokenHourSpace, layoutTokenHour12Space:
+			if len(value) > 0 && value[0] == ' ' {
+				value = value[1:]
+			}
+			hour, value, err = readNum(value, 2, false)
 		case layoutTokenMinute:
 			min, value, err = readNum(value, 2, false)
 		case layoutTokenMinuteLong:
 			min, value, err = readNum(value, 2, true)
-		case layoutTokenSecond:
-			sec, value, err = readNum(value, 2, false)
-		case layoutTokenSecondLong:
-			sec, value, err = readNum(value, 2, true)
+		case layoutTokenSecond, layoutTokenSecondLong:
+			sec, value, err = readNum(value, 2, token == layoutTokenSecondLong)
+			if err == nil && hasLeadingFraction(value) && !l.hasFractionAt(i+1) {
+				// the value has a fractional second but the layout has not
+				nsec, value = readLeadingFraction(value)
+			}
 		case layoutTokenMillisecondHundred:
-			nsec, value, err = readNum(value, 1, true)
-			if err != nil {
+			nsec, value, err = readFraction(value, 1, true)
+		case layoutTokenMillisecondTen:
+			nsec, value, err = readFraction(value, 2, true)
+		case layoutTokenMillisecond:
+			nsec, value, err = readFraction(value, 3, true)
+		case layoutTokenMicrosecond:
+			nsec, value, err = readFraction(value, 6, true)
+		case layoutTokenNanosecond:
+			nsec, value, err = readFraction(value, 9, true)
+		case layoutTokenFraction:
+			nsec, value, err = readFraction(value, 9, false)
+		case layoutTokenPMUpper, layoutTokenPMLower:
+			markers := locale.meridiems(token == layoutTokenPMLower)
+			if strings.HasPrefix(value, markers[0]) {
+				am = true
+				value = value[len(markers[0]):]
+			} else if strings.HasPrefix(value, markers[1]) {
+				pm = true
+				value = value[len(markers[1]):]
+			} else {
+				err = errParse
+			}
+		case layoutTokenTZ, layoutTokenTZColon, layoutTokenTZHour:
+			tzHr, tzMm, value, err = readOffset(value, token)
+			tzOffset, hasTZ = tzHr*60+tzMm, true
+		case layoutTokenTZISO, layoutTokenTZISOColon, layoutTokenTZISOHour:
+			if len(value) > 0 && value[0] == 'Z' {
+				value = value[1:]
+				tzOffset, hasTZ, zoneLoc = 0, true, time.UTC
 				break
 			}
-			nsec *= 100
-		case layoutTokenMillisecondTen:
-			nsec, value, err = readNum(value, 2, true)
+			tzHr, tzMm, value, err = readOffset(value, token)
+			tzOffset, hasTZ = tzHr*60+tzMm, true
+		case layoutTokenDaySpace:
+			if len(value) > 0 && value[0] == ' ' {
+				value = value[1:]
+			}
+			day, value, err = readNum(value, 2, false)
+		case layoutTokenDayOfYearSpace:
+			for i := 0; i < 2 && len(value) > 0 && value[0] == ' '; i++ {
+				value = value[1:]
+			}
+			yday, value, err = readNum(value, 3, false)
+		case layoutTokenDayOfYear:
+			yday, value, err = readNum(value, 3, false)
+		case layoutTokenDayOfYearLong:
+			yday, value, err = readNum(value, 3, true)
+		case layoutTokenQuarter:
+			quarter, value, err = readNum(value, 1, true)
+		case layoutTokenDayOrdinal:
+			day, value, err = readOrdinal(value, 2, locale)
+		case layoutTokenMonthOrdinal:
+			month, value, err = readOrdinal(value, 2, locale)
+			hasMonth = true
+		case layoutTokenQuarterOrdinal:
+			quarter, value, err = readOrdinal(value, 1, locale)
+		case layoutTokenDayOfWeekOrdinal:
+			weekday, value, err = readOrdinal(value, 1, locale)
+		case layoutTokenCentury:
+			century, value, err = readNum(value, 2, false)
+		case layoutTokenWeekSunday:
+			week, value, err = readNum(value, 2, false)
+			weekFirst = time.Sunday
+		case layoutTokenWeekMonday:
+			week, value, err = readNum(value, 2, false)
+			weekFirst = time.Monday
+		case layoutTokenUnix, layoutTokenUnixMilli:
+			unixNeg = len(value) > 0 && value[0] == '-'
+			unixSec, value, err = readUnix(value)
 			if err != nil {
 				break
 			}
-			nsec *= 10
-		case layoutTokenMillisecond:
-			nsec, value, err = readNum(value, 3, true)
-		case layoutTokenPMUpper:
-			if len(value) < 2 {
-				return Time{}, newParseError(oLayout, oValue, s, value)
-			}
-			str, value = value[0:2], value[2:]
-			switch str {
-			case "AM":
-				am = true
-			case "PM":
-				pm = true
-			default:
-				return Time{}, newParseError(oLayout, oValue, s, str)
+			hasUnix = true
+
+			var frac int
+			if hasLeadingFraction(value) && !l.hasFractionAt(i+1) {
+				frac, value = readLeadingFraction(value)
 			}
-		case layoutTokenPMLower:
-			if len(value) < 2 {
-				return Time{}, newParseError(oLayout, oValue, s, value)
+			if unixNeg {
+				frac = -frac
 			}
-			str, value = value[0:2], value[2:]
-			switch str {
-			case "am":
-				am = true
-			case "pm":
-				pm = true
-			default:
-				return Time{}, newParseError(oLayout, oValue, s, str)
-			}
-		case layoutTokenTZ:
-			if len(value) < 5 {
-				return Time{}, newParseError(oLayout, oValue, s, value)
-			}
-			var tzHr, tzMm int
-			tzHr, _, err = readNum(value[1:3], 2, true)
-			if err == nil {
-				tzMm, _, err = readNum(value[3:5], 2, true)
-			}
-			tzOffset = tzHr*60 + tzMm
-			switch value[0] {
-			case '+':
-			case '-':
-				tzOffset = -tzOffset
-			default:
-				return Time{}, newParseError(oLayout, oValue, s, value[0:5])
-			}
-
-			value = value[5:]
-		case layoutTokenTZColon:
-			if len(value) < 6 {
-				return Time{}, newParseError(oLayout, oValue, s, value)
-			}
-			var tzHr, tzMm int
-			tzHr, _, err = readNum(value[1:3], 2, true)
-			if err == nil {
-				tzMm, _, err = readNum(value[4:6], 2, true)
-			}
-			tzOffset = tzHr*60 + tzMm
-			switch value[0] {
-			case '+':
-			case '-':
-				tzOffset = -tzOffset
-			default:
-				return Time{}, newParseError(oLayout, oValue, s, value[0:6])
-			}
-
-			value = value[6:]
-		case layoutTokenNone:
-			if len(value) < len(s) {
-				return Time{}, newParseError(oLayout, oValue, s, value)
+			if token == layoutTokenUnixMilli {
+				unixSec, unixNsec = unixSec/1000, unixSec%1000*1e6+int64(frac)/1000
+			} else {
+				unixNsec = int64(frac)
+			}
+		case layoutTokenFractionFixed:
+			if len(value) == 0 || (value[0] != '.' && value[0] != ',') {
+				err = errParse
+				break
+			}
+			nsec, value, err = readFraction(value[1:], len(s)-1, true)
+			if err != nil {
+				value = prev
+			}
+		case layoutTokenFractionTrim:
+			if !hasLeadingFraction(value) {
+				break
+			}
+			nsec, value = readLeadingFraction(value)
+		case layoutTokenISOWeek, layoutTokenISOWeekLong:
+			isoWeek, value, err = readNum(value, 2, token == layoutTokenISOWeekLong)
+		case layoutTokenISOWeekYear:
+			isoYear, value, err = readNum(value, 2, true)
+			isoYear, hasISOYr = twoDigitYear(isoYear), true
+		case layoutTokenISOWeekYearLong:
+			isoYear, value, err = readNum(value, 4, true)
+			hasISOYr = true
+		case layoutTokenISOWeekday:
+			isoWday, value, err = readNum(value, 1, true)
+			// the day of week of the weeks that are not the ISO weeks like "%W"
+			weekday = isoWday % 7
+		case layoutTokenTZAbbr:
+			if s == "MST" {
+				zoneAbbr, value, err = readReferenceZoneAbbr(value)
+			} else {
+				zoneAbbr, value, err = readZoneAbbr(value)
 			}
-			str, value = value[0:len(s)], value[len(s):]
-			if s != str {
-				return Time{}, newParseError(oLayout, oValue, s, str)
+			zoneElem = parseElem{tok: tok, value: zoneAbbr, offset: len(oValue) - len(prev)}
+		case layoutTokenTZName:
+			zoneLoc, value, err = readZoneName(value)
+		case layoutTokenNone:
+			if !strings.HasPrefix(value, s) {
+				err = errParse
+				break
 			}
+			value = value[len(s):]
 		}
 
 		if err != nil {
-			return Time{}, newParseError(oLayout, oValue, s, value)
+			return Time{}, newParseError(oLayout, oValue, tok, value)
+		}
+
+		if opts.strict {
+			var msg string
+			elem := parseElem{
+				tok:    tok,
+				value:  prev[:len(prev)-len(value)],
+				offset: len(oValue) - len(prev),
+			}
+
+			switch token {
+			case layoutTokenMonth, layoutTokenMonthLong, layoutTokenMonthOrdinal:
+				msg = checkRange("month", month, 1, 12)
+			case layoutTokenDay, layoutTokenDayLong, layoutTokenDaySpace, layoutTokenDayOrdinal:
+				msg = checkRange("day", day, 1, 31)
+				dayElem = elem
+			case layoutTokenDayOfWeek, layoutTokenDayOfWeekAbbr, layoutTokenDayOfWeekFull,
+				layoutTokenDayOfWeekOrdinal:
+				msg = checkRange("day of week", weekday, 0, 6)
+				weekdayElem = elem
+			case layoutTokenHour, layoutTokenHourLong, layoutTokenHourSpace:
+				msg = checkRange("hour", hour, 0, 23)
+			case layoutTokenHour12, layoutTokenHour12Long, layoutTokenHour12Space:
+				msg = checkRange("hour", hour, 1, 12)
+			case layoutTokenMinute, layoutTokenMinuteLong:
+				msg = checkRange("minute", min, 0, 59)
+			case layoutTokenSecond, layoutTokenSecondLong:
+				msg = checkRange("second", sec, 0, 59)
+			case layoutTokenTZ, layoutTokenTZColon, layoutTokenTZHour,
+				layoutTokenTZISO, layoutTokenTZISOColon, layoutTokenTZISOHour:
+				msg = checkRange("timezone offset hour", abs(tzHr), 0, 23)
+				if msg == "" {
+					msg = checkRange("timezone offset minute", abs(tzMm), 0, 59)
+				}
+			case layoutTokenISOWeek, layoutTokenISOWeekLong:
+				msg = checkRange("week", isoWeek, 1, 53)
+				isoWeekElem = elem
+			case layoutTokenISOWeekday:
+				msg = checkRange("day of week", isoWday, 1, 7)
+				weekday = isoWday % 7
+				weekdayElem = elem
+			case layoutTokenDayOfYear, layoutTokenDayOfYearSpace, layoutTokenDayOfYearLong:
+				msg = checkRange("day of year", yday, 1, 366)
+				ydayElem = elem
+			case layoutTokenWeekSunday, layoutTokenWeekMonday:
+				msg = checkRange("week", week, 0, 53)
+			case layoutTokenQuarter, layoutTokenQuarterOrdinal:
+				msg = checkRange("quarter", quarter, 1, 4)
+				quarterElem = elem
+			}
+
+			if msg != "" {
+				return Time{}, newRangeError(oLayout, oValue, elem, msg)
+			}
+		}
+	}
+
+	if value != "" && opts.strict {
+		// the text after the end of the layout is rejected in strict mode like time.Parse
+		return Time{}, newExtraTextError(oLayout, oValue, value)
+	}
+
+	if hasUnix {
+		if unixNeg {
+			unixNsec -= int64(nsec)
+		} else {
+			unixNsec += int64(nsec)
+		}
+		tm := Unix(unixSec, unixNsec)
+
+		loc := opts.loc
+		if zoneLoc != nil {
+			loc = zoneLoc
+		}
+		if hasTZ {
+			return tm.In(fixedZone(tm, zoneAbbr, tzOffset*60, loc)), nil
+		}
+		return tm.In(loc), nil
+	}
+
+	if century >= 0 {
+		if yy >= 0 {
+			year = century*100 + yy
+		} else {
+			year = century * 100
+		}
+	}
+
+	if week >= 0 && isoWeek < 0 && yday < 0 {
+		wd := weekFirst
+		if weekday >= 0 {
+			wd = time.Weekday(weekday)
+		}
+		// the days before the first day of week of the year are in week 0
+		first := daysSinceWeekday(weekFirst, time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC).Weekday())
+		d := first + 1 + (week-1)*7 + daysSinceWeekday(wd, weekFirst)
+		if d < 1 && weekday < 0 {
+			d = 1
+		}
+		y, m, d := time.Date(year, time.January, d, 0, 0, 0, 0, time.UTC).Date()
+		year, month, day, hasMonth = y, int(m), d, true
+	}
+
+	if isoWeek >= 0 {
+		if !hasISOYr {
+			isoYear = year
+		}
+		if opts.strict {
+			msg := checkRange("week", isoWeek, 1, isoWeeksIn(isoYear))
+			if msg != "" {
+				return Time{}, newRangeError(oLayout, oValue, isoWeekElem, msg)
+			}
+		}
+
+		if isoWday < 1 {
+			isoWday = 1
+		}
+		y, m, d := isoWeekStart(isoYear, isoWeek)
+		y, m, d = time.Date(y, m, d+isoWday-1, 0, 0, 0, 0, time.UTC).Date()
+		year, month, day, hasMonth = y, int(m), d, true
+	}
+
+	if yday >= 0 {
+		if opts.strict {
+			if msg := checkRange("day of year", yday, 1, daysInYear(year)); msg != "" {
+				return Time{}, newRangeError(oLayout, oValue, ydayElem, msg)
+			}
+		}
+
+		// the day of year out of the year moves to the next or the previous year
+		y, m, d := time.Date(year, time.January, yday, 0, 0, 0, 0, time.UTC).Date()
+		if opts.strict && dayElem.tok.value != "" && (int(m) != month || d != day) {
+			return Time{}, newRangeError(oLayout, oValue, dayElem,
+				"day of year does not match the date")
+		}
+		year, month, day, hasMonth = y, int(m), d, true
+	}
+
+	if quarter >= 0 {
+		if !hasMonth {
+			month = (quarter-1)*3 + 1
+		} else if opts.strict && (month-1)/3+1 != quarter {
+			return Time{}, newRangeError(oLayout, oValue, quarterElem,
+				"quarter does not match the date, expected "+strconv.Itoa((month-1)/3+1))
+		}
+	}
+
+	if opts.strict && dayElem.tok.value != "" {
+		days := daysIn(time.Month(month), year)
+		if msg := checkRange("day", day, 1, days); msg != "" {
+			return Time{}, newRangeError(oLayout, oValue, dayElem, msg)
+		}
+	}
+
+	if opts.strict && weekdayElem.tok.value != "" {
+		expected := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC).Weekday()
+		if time.Weekday(weekday) != expected {
+			return Time{}, newRangeError(oLayout, oValue, weekdayElem,
+				"day of week does not match the date, expected "+fullWeekdayNames[expected])
 		}
 	}
 
@@ -202,14 +495,306 @@ func parse(layout, value string, loc *time.Location) (Time, error) {
 		hour = 0
 	}
 
-	nsec *= int(time.Millisecond)
+	if zoneElem.tok.value == "MST" && zoneLoc == nil {
+		// the "MST" element of the Go reference layout is resolved like time.Parse
+		wall := time.Date(year, time.Month(month), day, hour, min, sec, nsec, time.UTC)
+		return New(referenceZoneTime(wall, zoneAbbr, tzOffset*60, hasTZ, opts.loc)), nil
+	}
+
+	if !hasTZ && zoneAbbr != "" {
+		// the abbreviation is the zone name of the location at that time
+		tm := Date(year, time.Month(month), day, hour, min, sec, nsec, opts.loc)
+		if name, _ := tm.Zone(); name == zoneAbbr && zoneLoc == nil {
+			return tm, nil
+		}
+
+		offset, ok := numericZoneAbbrOffset(zoneAbbr)
+		if !ok {
+			offset, ok = lookupZoneAbbreviation(zoneAbbr)
+		}
+		if !ok {
+			return Time{}, newElemError(oLayout, oValue, zoneElem, "unknown time zone abbreviation",
+				ErrUnexpectedText)
+		}
+		tzOffset, hasTZ = offset/60, true
+	}
 
-	if tzOffset == -1 {
+	if !hasTZ {
+		loc := opts.loc
+		if zoneLoc != nil {
+			loc = zoneLoc
+		}
 		return Date(year, time.Month(month), day, hour, min, sec, nsec, loc), nil
-	} else {
-		tm := Date(year, time.Month(month), day, hour, min, sec, nsec, time.UTC)
-		tm = tm.Add(time.Duration(tzOffset) * time.Minute)
+	}
+
+	tm := Date(year, time.Month(month), day, hour, min, sec, nsec, time.UTC)
+	tm = tm.Add(-time.Duration(tzOffset) * time.Minute)
+
+	loc := opts.loc
+	if zoneLoc != nil {
+		loc = zoneLoc
+	}
+	return tm.In(fixedZone(tm, zoneAbbr, tzOffset*60, loc)), nil
+}
+
+// fixedZone returns the location if it has the same offset at the time instant, or returns a fixed
+// zone with the name and the offset (in seconds east of UTC).
+func fixedZone(t Time, name string, offset int, loc *time.Location) *time.Location {
+	if loc != nil {
+		if _, locOffset := t.In(loc).Zone(); locOffset == offset {
+			return loc
+		}
+	}
+
+	return time.FixedZone(name, offset)
+}
+
+// hasFractionAt reports whether the token at the index is a fractional second, or a period or
+// comma separator that is followed by a fractional second.
+func (l *Layout) hasFractionAt(i int) bool {
+	if i >= len(l.tokens) {
+		return false
+	}
 
-		return tm, nil
+	switch tok := l.tokens[i]; tok.kind {
+	case layoutTokenFractionFixed, layoutTokenFractionTrim, layoutTokenFraction,
+		layoutTokenMillisecondHundred, layoutTokenMillisecondTen, layoutTokenMillisecond,
+		layoutTokenMicrosecond, layoutTokenNanosecond:
+		return true
+	case layoutTokenNone:
+		return tok.value == "." || tok.value == ","
+	default:
+		return false
+	}
+}
+
+// checkRange returns the message that describes the field is out of range, or an empty string if
+// the value is in the range [min, max].
+func checkRange(field string, value, min, max int) string {
+	if value >= min && value <= max {
+		return ""
+	}
+	return field + " out of range " + strconv.Itoa(min) + ".." + strconv.Itoa(max)
+}
+
+// parseElem is the element of the value that is parsed by a layout token, it's kept to report the
+// errors that are found after parsing all the elements.
+type parseElem struct {
+	tok    layoutToken
+	value  string
+	offset int
+}
+
+// layoutTokenPatterns is the patterns of the numeric tokens, '0' is a digit, '+' is a sign, '.' is
+// a period or a comma, and the other characters are the characters themselves. The tokens that
+// accept a variable number of digits have a single digit pattern.
+var layoutTokenPatterns = map[int]string{
+	layoutTokenYearLong:           "0000",
+	layoutTokenYear:               "00",
+	layoutTokenMonth:              "0",
+	layoutTokenMonthLong:          "00",
+	layoutTokenDay:                "0",
+	layoutTokenDayLong:            "00",
+	layoutTokenDaySpace:           "0",
+	layoutTokenDayOfWeek:          "0",
+	layoutTokenHour:               "0",
+	layoutTokenHourLong:           "00",
+	layoutTokenHourSpace:          "0",
+	layoutTokenHour12:             "0",
+	layoutTokenHour12Long:         "00",
+	layoutTokenHour12Space:        "0",
+	layoutTokenMinute:             "0",
+	layoutTokenMinuteLong:         "00",
+	layoutTokenSecond:             "0",
+	layoutTokenSecondLong:         "00",
+	layoutTokenMillisecondHundred: "0",
+	layoutTokenMillisecondTen:     "00",
+	layoutTokenMillisecond:        "000",
+	layoutTokenMicrosecond:        "000000",
+	layoutTokenNanosecond:         "000000000",
+	layoutTokenFraction:           "0",
+	layoutTokenTZ:                 "+0000",
+	layoutTokenTZColon:            "+00:00",
+	layoutTokenTZHour:             "+00",
+	layoutTokenTZISO:              "+0000",
+	layoutTokenTZISOColon:         "+00:00",
+	layoutTokenTZISOHour:          "+00",
+	layoutTokenDayOfYear:          "0",
+	layoutTokenDayOfYearSpace:     "0",
+	layoutTokenDayOfYearLong:      "000",
+	layoutTokenISOWeek:            "0",
+	layoutTokenISOWeekLong:        "00",
+	layoutTokenISOWeekYear:        "00",
+	layoutTokenISOWeekYearLong:    "0000",
+	layoutTokenISOWeekday:         "0",
+	layoutTokenQuarter:            "0",
+	layoutTokenDayOrdinal:         "0",
+	layoutTokenMonthOrdinal:       "0",
+	layoutTokenQuarterOrdinal:     "0",
+	layoutTokenDayOfWeekOrdinal:   "0",
+	layoutTokenCentury:            "0",
+	layoutTokenWeekSunday:         "0",
+	layoutTokenWeekMonday:         "0",
+	layoutTokenUnix:               "0",
+	layoutTokenUnixMilli:          "0",
+}
+
+// layoutTokenDescriptions is the descriptions of the tokens that are used as the expected element
+// of the parse errors.
+var layoutTokenDescriptions = map[int]string{
+	layoutTokenYearLong:           "4-digit year",
+	layoutTokenYear:               "2-digit year",
+	layoutTokenMonth:              "month",
+	layoutTokenMonthLong:          "2-digit month",
+	layoutTokenMonthAbbr:          "abbreviated month name",
+	layoutTokenMonthFull:          "month name",
+	layoutTokenDay:                "day",
+	layoutTokenDayLong:            "2-digit day",
+	layoutTokenDaySpace:           "space-padded day",
+	layoutTokenDayOfWeek:          "day of week",
+	layoutTokenDayOfWeekAbbr:      "abbreviated weekday name",
+	layoutTokenDayOfWeekFull:      "weekday name",
+	layoutTokenHour:               "hour",
+	layoutTokenHourLong:           "2-digit hour",
+	layoutTokenHourSpace:          "space-padded hour",
+	layoutTokenHour12:             "hour",
+	layoutTokenHour12Long:         "2-digit hour",
+	layoutTokenHour12Space:        "space-padded hour",
+	layoutTokenMinute:             "minute",
+	layoutTokenMinuteLong:         "2-digit minute",
+	layoutTokenSecond:             "second",
+	layoutTokenSecondLong:         "2-digit second",
+	layoutTokenMillisecondHundred: "1-digit fractional second",
+	layoutTokenMillisecondTen:     "2-digit fractional second",
+	layoutTokenMillisecond:        "3-digit fractional second",
+	layoutTokenMicrosecond:        "6-digit fractional second",
+	layoutTokenNanosecond:         "9-digit fractional second",
+	layoutTokenFraction:           "fractional second",
+	layoutTokenFractionFixed:      "fractional second",
+	layoutTokenPMUpper:            "AM or PM",
+	layoutTokenPMLower:            "am or pm",
+	layoutTokenTZ:                 "timezone offset",
+	layoutTokenTZColon:            "timezone offset",
+	layoutTokenTZHour:             "timezone offset",
+	layoutTokenTZISO:              "timezone offset or Z",
+	layoutTokenTZISOColon:         "timezone offset or Z",
+	layoutTokenTZISOHour:          "timezone offset or Z",
+	layoutTokenTZAbbr:             "timezone abbreviation",
+	layoutTokenTZName:             "timezone name",
+	layoutTokenDayOfYear:          "day of year",
+	layoutTokenDayOfYearSpace:     "space-padded day of year",
+	layoutTokenDayOfYearLong:      "3-digit day of year",
+	layoutTokenISOWeek:            "week",
+	layoutTokenISOWeekLong:        "2-digit week",
+	layoutTokenISOWeekYear:        "2-digit week-based year",
+	layoutTokenISOWeekYearLong:    "4-digit week-based year",
+	layoutTokenISOWeekday:         "day of week",
+	layoutTokenQuarter:            "quarter",
+	layoutTokenDayOrdinal:         "ordinal day",
+	layoutTokenMonthOrdinal:       "ordinal month",
+	layoutTokenQuarterOrdinal:     "ordinal quarter",
+	layoutTokenDayOfWeekOrdinal:   "ordinal day of week",
+	layoutTokenCentury:            "century",
+	layoutTokenWeekSunday:         "week",
+	layoutTokenWeekMonday:         "week",
+	layoutTokenUnix:               "Unix timestamp",
+	layoutTokenUnixMilli:          "Unix timestamp in milliseconds",
+}
+
+// describeToken returns the description of the layout token, or the quoted text of the literal.
+func describeToken(tok layoutToken) string {
+	if tok.kind == layoutTokenNone {
+		return strconv.Quote(tok.value)
+	}
+	return layoutTokenDescriptions[tok.kind]
+}
+
+// failedElem finds the first character in the value that cannot be parsed by the layout token,
+// and returns the offset of the character, the element of the value that ends at the character,
+// and the cause of the error.
+func failedElem(tok layoutToken, value string) (int, string, error) {
+	pattern, numeric := layoutTokenPatterns[tok.kind]
+
+	switch {
+	case tok.kind == layoutTokenNone:
+		pattern = tok.value
+	case tok.kind == layoutTokenFractionFixed:
+		pattern = "." + strings.Repeat("0", len(tok.value)-1)
+	case !numeric:
+		// the names are compared as a whole
+		if len(value) == 0 {
+			return 0, "", ErrTooShort
+		}
+		return 0, value[:nextElemLen(value, 0)], ErrUnexpectedText
+	}
+
+	i := 0
+	if (tok.kind == layoutTokenUnix || tok.kind == layoutTokenUnixMilli) &&
+		len(value) > 0 && (value[0] == '-' || value[0] == '+') {
+		i++
+	}
+	for j := 0; j < len(pattern); i, j = i+1, j+1 {
+		if i >= len(value) {
+			return i, value, ErrTooShort
+		}
+		if !matchPattern(pattern[j], value[i], tok.kind == layoutTokenNone) {
+			_, n := utf8.DecodeRuneInString(value[i:])
+			if tok.kind == layoutTokenNone && i+n < len(pattern) {
+				// the literal is compared as a whole
+				n = len(pattern) - i
+				if i+n > len(value) {
+					n = len(value) - i
+				}
+			}
+			return i, value[:i+n], ErrUnexpectedText
+		}
+	}
+
+	// the pattern matches the value, the variable-width numbers may have more digits and a suffix
+	for ; i < len(value) && isDigit(value[i]); i++ {
+	}
+	if i >= len(value) {
+		if tok.kind == layoutTokenUnix || tok.kind == layoutTokenUnixMilli {
+			return 0, value, ErrRange
+		}
+		return i, value, ErrTooShort
+	}
+
+	_, n := utf8.DecodeRuneInString(value[i:])
+	return i, value[:i+n], ErrUnexpectedText
+}
+
+// matchPattern reports whether the character matches the character of the pattern.
+func matchPattern(p, c byte, literal bool) bool {
+	if literal {
+		return p == c
+	}
+
+	switch p {
+	case '0':
+		return isDigit(c)
+	case '+':
+		return c == '+' || c == '-'
+	case '.':
+		return c == '.' || c == ','
+	default:
+		return p == c
+	}
+}
+
+// nextElemLen returns the length of the element at the index of the value, it's a run of the
+// letters, or a character.
+func nextElemLen(value string, i int) int {
+	j := i
+	for j < len(value) {
+		r, n := utf8.DecodeRuneInString(value[j:])
+		if !unicode.IsLetter(r) {
+			if j == i {
+				return n
+			}
+			break
+		}
+		j += n
 	}
+	return j - i
 }
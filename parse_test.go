@@ -1,6 +1,7 @@
 package date_test
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -59,10 +60,10 @@ func TestParse(t *testing.T) {
 			date.Date(2024, 1, 1, 22, 0, 0, 0, time.Local),
 			"YYYY-MM-DD h:mm:ss A", "2024-01-01 10:00:00 PM",
 		},
-		{date.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC), "YYYY-MM-DD ZZ", "2024-01-01 +0800"},
-		{date.Date(2023, 12, 31, 16, 0, 0, 0, time.UTC), "YYYY-MM-DD ZZ", "2024-01-01 -0800"},
-		{date.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC), "YYYY-MM-DD Z", "2024-01-01 +08:00"},
-		{date.Date(2023, 12, 31, 16, 0, 0, 0, time.UTC), "YYYY-MM-DD Z", "2024-01-01 -08:00"},
+		{date.Date(2023, 12, 31, 16, 0, 0, 0, time.UTC), "YYYY-MM-DD ZZ", "2024-01-01 +0800"},
+		{date.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC), "YYYY-MM-DD ZZ", "2024-01-01 -0800"},
+		{date.Date(2023, 12, 31, 16, 0, 0, 0, time.UTC), "YYYY-MM-DD Z", "2024-01-01 +08:00"},
+		{date.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC), "YYYY-MM-DD Z", "2024-01-01 -08:00"},
 		{
 			date.Date(2024, 1, 1, 0, 0, 0, 999000000, time.Local),
 			"YYYY-MM-DD HH:mm:ss.SSS", "2024-01-01 00:00:00.999",
@@ -75,10 +76,47 @@ func TestParse(t *testing.T) {
 			date.Date(2024, 1, 1, 0, 0, 0, 900000000, time.Local),
 			"YYYY-MM-DD HH:mm:ss.S", "2024-01-01 00:00:00.9",
 		},
+		{
+			date.Date(2024, 1, 1, 0, 0, 0, 123456000, time.Local),
+			"YYYY-MM-DD HH:mm:ss.SSSSSS", "2024-01-01 00:00:00.123456",
+		},
+		{
+			date.Date(2024, 1, 1, 0, 0, 0, 123456789, time.Local),
+			"YYYY-MM-DD HH:mm:ss.SSSSSSSSS", "2024-01-01 00:00:00.123456789",
+		},
+		{
+			date.Date(2024, 1, 1, 0, 0, 0, 120000000, time.Local),
+			"YYYY-MM-DD HH:mm:ss.F", "2024-01-01 00:00:00.12",
+		},
+		{
+			date.Date(2024, 1, 1, 0, 0, 0, 1234567, time.Local),
+			"YYYY-MM-DD HH:mm:ss.F", "2024-01-01 00:00:00.001234567",
+		},
 		{
 			date.Date(2006, time.January, 2, 15, 4, 5, 0, time.Local),
 			"2006-01-02T15:04:05", "2006-01-02T15:04:05",
 		},
+		{date.Date(2024, 2, 29, 0, 0, 0, 0, time.Local), "YYYY-DDDD", "2024-060"},
+		{date.Date(2023, 3, 1, 0, 0, 0, 0, time.Local), "YYYY-DDD", "2023-60"},
+		// the day of year out of the year moves to the next or the previous year
+		{date.Date(2024, 1, 1, 0, 0, 0, 0, time.Local), "YYYY-DDDD", "2023-366"},
+		{date.Date(2023, 12, 31, 0, 0, 0, 0, time.Local), "YYYY-DDDD", "2024-000"},
+		{date.Date(2024, 7, 1, 0, 0, 0, 0, time.Local), "YYYY \\QQ", "2024 Q3"},
+		{date.Date(2024, 2, 1, 0, 0, 0, 0, time.Local), "YYYY-MM \\QQ", "2024-02 Q3"},
+		{date.Date(2024, 1, 1, 0, 0, 0, 0, time.Local), "MMMM Do, YYYY", "January 1st, 2024"},
+		{date.Date(2024, 3, 22, 0, 0, 0, 0, time.Local), "Do Mo YYYY", "22nd 3rd 2024"},
+		{
+			date.Date(2024, 10, 1, 0, 0, 0, 0, time.Local),
+			"Qo \\q\\u\\a\\r\\t\\e\\r YYYY", "4th quarter 2024",
+		},
+		{
+			date.Date(2024, 1, 10, 15, 4, 0, 0, time.Local),
+			"YYYY-MM-DD[The day] [at] HH:mm", "2024-01-10The day at 15:04",
+		},
+		{
+			date.Date(2024, 10, 1, 0, 0, 0, 0, time.Local),
+			"Qo [quarter] YYYY", "4th quarter 2024",
+		},
 	}
 
 	for _, test := range cases {
@@ -95,30 +133,115 @@ func TestParseWithError(t *testing.T) {
 	cases := []struct {
 		layout        string
 		str           string
+		offset        int
+		cause         error
 		expectedError string
 	}{
-		{"oo", "o", `parsing time "o" as "oo": cannot parse "o" as ""`},
-		{"oo", "ttt", `parsing time "ttt" as "oo": cannot parse "o" as "t"`},
-		{"YYYY", "2xxx", `parsing time "2xxx" as "YYYY": cannot parse "YYYY" as "2xxx"`},
-		{"YY", "2x", `parsing time "2x" as "YY": cannot parse "YY" as "2x"`},
-		{"MMM", "XXX", `parsing time "XXX" as "MMM": cannot parse "MMM" as "XXX"`},
-		{"MMMM", "unknown", `parsing time "unknown" as "MMMM": cannot parse "MMMM" as "unknown"`},
-		{"S", "X", `parsing time "X" as "S": cannot parse "S" as "X"`},
-		{"SS", "X", `parsing time "X" as "SS": cannot parse "SS" as "X"`},
-		{"A", "p", `parsing time "p" as "A": cannot parse "A" as "p"`},
-		{"A", "am", `parsing time "am" as "A": cannot parse "A" as "am"`},
-		{"a", "p", `parsing time "p" as "a": cannot parse "a" as "p"`},
-		{"a", "AM", `parsing time "AM" as "a": cannot parse "a" as "AM"`},
-		{"Z", "+08", `parsing time "+08" as "Z": cannot parse "Z" as "+08"`},
-		{"Z", "x08:00", `parsing time "x08:00" as "Z": cannot parse "Z" as "x08:00"`},
-		{"ZZ", "+08", `parsing time "+08" as "ZZ": cannot parse "ZZ" as "+08"`},
-		{"ZZ", "x0800", `parsing time "x0800" as "ZZ": cannot parse "ZZ" as "x0800"`},
+		{
+			"oo", "o", 1, date.ErrTooShort,
+			`parsing time "o" as "oo": cannot parse "o" as "": expected "o"`,
+		},
+		{
+			"oo", "ttt", 0, date.ErrUnexpectedText,
+			`parsing time "ttt" as "oo": cannot parse "o" as "t": expected "o"`,
+		},
+		{
+			"YYYY", "2xxx", 1, date.ErrUnexpectedText,
+			`parsing time "2xxx" as "YYYY": cannot parse "YYYY" as "2x": expected 4-digit year`,
+		},
+		{
+			"YY", "2x", 1, date.ErrUnexpectedText,
+			`parsing time "2x" as "YY": cannot parse "YY" as "2x": expected 2-digit year`,
+		},
+		{
+			"MMM", "XXX", 0, date.ErrUnexpectedText,
+			`parsing time "XXX" as "MMM": cannot parse "MMM" as "XXX": expected abbreviated month name`,
+		},
+		{
+			"MMMM", "unknown", 0, date.ErrUnexpectedText,
+			`parsing time "unknown" as "MMMM": cannot parse "MMMM" as "unknown": expected month name`,
+		},
+		{
+			"S", "X", 0, date.ErrUnexpectedText,
+			`parsing time "X" as "S": cannot parse "S" as "X": expected 1-digit fractional second`,
+		},
+		{
+			"SS", "X", 0, date.ErrUnexpectedText,
+			`parsing time "X" as "SS": cannot parse "SS" as "X": expected 2-digit fractional second`,
+		},
+		{
+			"SSSSSS", "12345", 5, date.ErrTooShort,
+			`parsing time "12345" as "SSSSSS": cannot parse "SSSSSS" as "12345": ` +
+				`expected 6-digit fractional second`,
+		},
+		{
+			"F", "X", 0, date.ErrUnexpectedText,
+			`parsing time "X" as "F": cannot parse "F" as "X": expected fractional second`,
+		},
+		{
+			"A", "p", 0, date.ErrUnexpectedText,
+			`parsing time "p" as "A": cannot parse "A" as "p": expected AM or PM`,
+		},
+		{
+			"A", "am", 0, date.ErrUnexpectedText,
+			`parsing time "am" as "A": cannot parse "A" as "am": expected AM or PM`,
+		},
+		{
+			"a", "p", 0, date.ErrUnexpectedText,
+			`parsing time "p" as "a": cannot parse "a" as "p": expected am or pm`,
+		},
+		{
+			"a", "AM", 0, date.ErrUnexpectedText,
+			`parsing time "AM" as "a": cannot parse "a" as "AM": expected am or pm`,
+		},
+		{
+			"Z", "+08", 3, date.ErrTooShort,
+			`parsing time "+08" as "Z": cannot parse "Z" as "+08": expected timezone offset`,
+		},
+		{
+			"Z", "x08:00", 0, date.ErrUnexpectedText,
+			`parsing time "x08:00" as "Z": cannot parse "Z" as "x": expected timezone offset`,
+		},
+		{
+			"Z", "+0800", 3, date.ErrUnexpectedText,
+			`parsing time "+0800" as "Z": cannot parse "Z" as "+080": expected timezone offset`,
+		},
+		{
+			"ZZ", "+08", 3, date.ErrTooShort,
+			`parsing time "+08" as "ZZ": cannot parse "ZZ" as "+08": expected timezone offset`,
+		},
+		{
+			"ZZ", "x0800", 0, date.ErrUnexpectedText,
+			`parsing time "x0800" as "ZZ": cannot parse "ZZ" as "x": expected timezone offset`,
+		},
+		{
+			"YYYY [at] HH", "2024 on 12", 5, date.ErrUnexpectedText,
+			`parsing time "2024 on 12" as "YYYY [at] HH": cannot parse "at" as "on": expected "at"`,
+		},
+		{
+			"YYYY-MM-DD", "2024-01-1x", 9, date.ErrUnexpectedText,
+			`parsing time "2024-01-1x" as "YYYY-MM-DD": cannot parse "DD" as "1x": expected 2-digit day`,
+		},
+		{
+			"YYYY-MM-DD", "2024-01", 7, date.ErrTooShort,
+			`parsing time "2024-01" as "YYYY-MM-DD": cannot parse "-" as "": expected "-"`,
+		},
+		{
+			"MMMM Do", "January 1x", 9, date.ErrUnexpectedText,
+			`parsing time "January 1x" as "MMMM Do": cannot parse "Do" as "1x": expected ordinal day`,
+		},
 	}
 
 	for _, test := range cases {
 		_, err := date.Parse(test.layout, test.str)
 		a.NotNilNow(err)
 		a.EqualNow(err.Error(), test.expectedError)
+		a.TrueNow(errors.Is(err, test.cause), test.str)
+
+		var pe *date.ParseError
+		a.TrueNow(errors.As(err, &pe))
+		a.EqualNow(pe.Offset, test.offset, test.str)
+		a.EqualNow(pe.Message, "")
 	}
 }
 
@@ -162,3 +285,519 @@ func TestParseInLocationName(t *testing.T) {
 	_, err = date.ParseInLocationName("YYYY-MM-DD", "2024-01-01", "Unknown")
 	a.NotNilNow(err)
 }
+
+func TestParseStrict(t *testing.T) {
+	a := assert.New(t)
+
+	cases := []struct {
+		expect date.Time
+		layout string
+		str    string
+	}{
+		{
+			date.Date(2024, 2, 29, 23, 59, 59, 0, time.Local),
+			"YYYY-MM-DD HH:mm:ss", "2024-02-29 23:59:59",
+		},
+		{
+			date.Date(2024, 1, 1, 12, 0, 0, 0, time.Local),
+			"YYYY-MM-DD hh:mm A", "2024-01-01 12:00 PM",
+		},
+		{
+			date.Date(2024, 1, 10, 0, 0, 0, 0, time.Local),
+			"dddd, YYYY-MM-DD", "Wednesday, 2024-01-10",
+		},
+		{
+			date.Date(2024, 1, 10, 0, 0, 0, 0, time.Local),
+			"ddd d YYYY-MM-DD", "Wed 3 2024-01-10",
+		},
+		{
+			date.Date(2024, 1, 16, 0, 0, 0, 0, time.Local),
+			"GGGG-\\WWW-E", "2024-W03-2",
+		},
+		{
+			date.Date(2020, 12, 28, 0, 0, 0, 0, time.Local),
+			"GG-\\WW", "20-W53",
+		},
+		{
+			date.Date(2024, 12, 31, 0, 0, 0, 0, time.Local),
+			"YYYY-DDDD", "2024-366",
+		},
+		{
+			date.Date(2024, 10, 1, 0, 0, 0, 0, time.Local),
+			"YYYY \\QQ", "2024 Q4",
+		},
+	}
+
+	for _, test := range cases {
+		tm, err := date.ParseStrict(test.layout, test.str)
+		a.NilNow(err)
+		a.TrueNow(tm.Equal(test.expect))
+	}
+
+	tm, err := date.ParseStrictInLocation("YYYY-MM-DD", "2024-01-01", time.UTC)
+	a.NilNow(err)
+	a.TrueNow(tm.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestParseStrictWithError(t *testing.T) {
+	a := assert.New(t)
+
+	cases := []struct {
+		layout        string
+		str           string
+		expectedError string
+	}{
+		{
+			"YYYY-MM-DD", "2024-02-31",
+			`parsing time "2024-02-31" as "YYYY-MM-DD": cannot parse "DD" as "31": ` +
+				`day out of range 1..29`,
+		},
+		{
+			"YYYY-MM-DD", "2023-02-29",
+			`parsing time "2023-02-29" as "YYYY-MM-DD": cannot parse "DD" as "29": ` +
+				`day out of range 1..28`,
+		},
+		{
+			"YYYY-MM-DD", "2024-13-01",
+			`parsing time "2024-13-01" as "YYYY-MM-DD": cannot parse "MM" as "13": ` +
+				`month out of range 1..12`,
+		},
+		{
+			"YYYY-M-D", "2024-1-0",
+			`parsing time "2024-1-0" as "YYYY-M-D": cannot parse "D" as "0": day out of range 1..31`,
+		},
+		{
+			"HH:mm", "13:75",
+			`parsing time "13:75" as "HH:mm": cannot parse "mm" as "75": minute out of range 0..59`,
+		},
+		{
+			"HH:mm", "24:00",
+			`parsing time "24:00" as "HH:mm": cannot parse "HH" as "24": hour out of range 0..23`,
+		},
+		{
+			"hh:mm A", "00:00 PM",
+			`parsing time "00:00 PM" as "hh:mm A": cannot parse "hh" as "00": ` +
+				`hour out of range 1..12`,
+		},
+		{
+			"HH:mm:ss", "12:00:60",
+			`parsing time "12:00:60" as "HH:mm:ss": cannot parse "ss" as "60": ` +
+				`second out of range 0..59`,
+		},
+		{
+			"d", "7",
+			`parsing time "7" as "d": cannot parse "d" as "7": day of week out of range 0..6`,
+		},
+		{
+			"dddd, YYYY-MM-DD", "Monday, 2024-01-10",
+			`parsing time "Monday, 2024-01-10" as "dddd, YYYY-MM-DD": cannot parse "dddd" as ` +
+				`"Monday": day of week does not match the date, expected Wednesday`,
+		},
+		{
+			"YYYY-MM-DD Z", "2024-01-01 +08:60",
+			`parsing time "2024-01-01 +08:60" as "YYYY-MM-DD Z": cannot parse "Z" as "+08:60": ` +
+				`timezone offset minute out of range 0..59`,
+		},
+		{
+			"GGGG-\\WWW", "2021-W53",
+			`parsing time "2021-W53" as "GGGG-\WWW": cannot parse "WW" as "53": ` +
+				`week out of range 1..52`,
+		},
+		{
+			"GGGG-\\WWW-E", "2024-W03-8",
+			`parsing time "2024-W03-8" as "GGGG-\WWW-E": cannot parse "E" as "8": ` +
+				`day of week out of range 1..7`,
+		},
+		{
+			"YYYY-DDD", "2023-366",
+			`parsing time "2023-366" as "YYYY-DDD": cannot parse "DDD" as "366": ` +
+				`day of year out of range 1..365`,
+		},
+		{
+			"YYYY \\QQ", "2024 Q5",
+			`parsing time "2024 Q5" as "YYYY \QQ": cannot parse "Q" as "5": ` +
+				`quarter out of range 1..4`,
+		},
+		{
+			"YYYY-MM \\QQ", "2024-02 Q3",
+			`parsing time "2024-02 Q3" as "YYYY-MM \QQ": cannot parse "Q" as "3": ` +
+				`quarter does not match the date, expected 1`,
+		},
+		{
+			"MMMM Do, YYYY", "January 32nd, 2024",
+			`parsing time "January 32nd, 2024" as "MMMM Do, YYYY": cannot parse "Do" as "32nd": ` +
+				`day out of range 1..31`,
+		},
+		{
+			"do YYYY-MM-DD", "1st 2024-01-10",
+			`parsing time "1st 2024-01-10" as "do YYYY-MM-DD": cannot parse "do" as "1st": ` +
+				`day of week does not match the date, expected Wednesday`,
+		},
+	}
+
+	for _, test := range cases {
+		_, err := date.ParseStrict(test.layout, test.str)
+		a.NotNilNow(err)
+		a.EqualNow(err.Error(), test.expectedError)
+
+		a.TrueNow(errors.Is(err, date.ErrRange))
+
+		var pe *date.ParseError
+		a.TrueNow(errors.As(err, &pe))
+		a.NotEqualNow(pe.Message, "")
+	}
+
+	_, err := date.ParseStrict("YYYY-MM-DD", "2024-02-31")
+	var pe *date.ParseError
+	a.TrueNow(errors.As(err, &pe))
+	a.EqualNow(pe.Offset, 8)
+	a.EqualNow(pe.ValueElem, "31")
+	a.EqualNow(pe.Expected, "2-digit day")
+
+	// non-strict mode normalizes the values
+	tm, err := date.ParseInLocation("YYYY-MM-DD", "2024-02-31", time.UTC)
+	a.NilNow(err)
+	a.TrueNow(tm.Equal(time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestParseWithOffset(t *testing.T) {
+	a := assert.New(t)
+
+	tzSH, _ := time.LoadLocation("Asia/Shanghai")
+
+	cases := []struct {
+		layout string
+		str    string
+		offset int
+	}{
+		{"YYYY-MM-DD HH:mm:ss Z", "2024-01-10 09:00:00 +08:00", 8 * 3600},
+		{"YYYY-MM-DD HH:mm:ss Z", "2024-01-10 09:00:00 -08:00", -8 * 3600},
+		{"YYYY-MM-DD HH:mm:ss Z", "2024-01-10 09:00:00 +05:30", 5*3600 + 30*60},
+		{"YYYY-MM-DD HH:mm:ss Z", "2024-01-10 09:00:00 -03:30", -3*3600 - 30*60},
+		{"YYYY-MM-DD HH:mm:ss Z", "2024-01-10 09:00:00 +00:00", 0},
+		{"YYYY-MM-DD HH:mm:ss ZZ", "2024-01-10 09:00:00 +0800", 8 * 3600},
+		{"YYYY-MM-DD HH:mm:ss ZZ", "2024-01-10 09:00:00 -0800", -8 * 3600},
+		{"YYYY-MM-DD HH:mm:ss ZZ", "2024-01-10 09:00:00 +0530", 5*3600 + 30*60},
+		{"YYYY-MM-DD HH:mm:ss ZZ", "2024-01-10 09:00:00 -0930", -9*3600 - 30*60},
+	}
+
+	for _, test := range cases {
+		tm, err := date.ParseInLocation(test.layout, test.str, tzSH)
+		a.NilNow(err)
+
+		_, offset := tm.Zone()
+		a.EqualNow(offset, test.offset)
+		a.EqualNow(tm.Format(test.layout), test.str)
+		a.EqualNow(tm.Hour(), 9)
+	}
+}
+
+func TestParseWithOffsetOfLocation(t *testing.T) {
+	a := assert.New(t)
+
+	tzSH, _ := time.LoadLocation("Asia/Shanghai")
+	tzLA, _ := time.LoadLocation("America/Los_Angeles")
+
+	tm, err := date.ParseInLocation("YYYY-MM-DD HH:mm Z", "2024-01-10 09:00 +08:00", tzSH)
+	a.NilNow(err)
+	a.EqualNow(tm.Location(), tzSH)
+	a.TrueNow(tm.Equal(time.Date(2024, 1, 10, 9, 0, 0, 0, tzSH)))
+
+	// the offset of Los Angeles is -07:00 in July, and -08:00 in January
+	tm, err = date.ParseInLocation("YYYY-MM-DD HH:mm Z", "2024-07-10 09:00 -07:00", tzLA)
+	a.NilNow(err)
+	a.EqualNow(tm.Location(), tzLA)
+
+	tm, err = date.ParseInLocation("YYYY-MM-DD HH:mm Z", "2024-01-10 09:00 -07:00", tzLA)
+	a.NilNow(err)
+	a.NotEqualNow(tm.Location(), tzLA)
+	a.EqualNow(tm.Format("YYYY-MM-DD HH:mm Z"), "2024-01-10 09:00 -07:00")
+}
+
+func TestParseWithZone(t *testing.T) {
+	a := assert.New(t)
+
+	tzNY, _ := time.LoadLocation("America/New_York")
+	tzSH, _ := time.LoadLocation("Asia/Shanghai")
+
+	tm, err := date.Parse("YYYY-MM-DD HH:mm zz", "2024-01-10 09:00 America/New_York")
+	a.NilNow(err)
+	a.EqualNow(tm.Location().String(), "America/New_York")
+	a.TrueNow(tm.Equal(time.Date(2024, 1, 10, 9, 0, 0, 0, tzNY)))
+	a.EqualNow(tm.Format("YYYY-MM-DD HH:mm zz"), "2024-01-10 09:00 America/New_York")
+
+	// the offset after the name is not a part of the name
+	tm, err = date.Parse("YYYY-MM-DD HH:mm zzZ", "2024-01-10 09:00 America/New_York-05:00")
+	a.NilNow(err)
+	a.TrueNow(tm.Equal(time.Date(2024, 1, 10, 9, 0, 0, 0, tzNY)))
+
+	_, err = date.Parse("YYYY-MM-DD HH:mm zz", "2024-01-10 09:00 Unknown/Zone")
+	a.NotNilNow(err)
+
+	// the abbreviation of the location
+	tm, err = date.ParseInLocation("YYYY-MM-DD HH:mm z", "2024-01-10 09:00 CST", tzSH)
+	a.NilNow(err)
+	a.EqualNow(tm.Location(), tzSH)
+	a.TrueNow(tm.Equal(time.Date(2024, 1, 10, 9, 0, 0, 0, tzSH)))
+
+	// the abbreviation in the table
+	tm, err = date.ParseInLocation("YYYY-MM-DD HH:mm z", "2024-01-10 09:00 CST", time.UTC)
+	a.NilNow(err)
+	_, offset := tm.Zone()
+	a.EqualNow(offset, -6*3600)
+	a.EqualNow(tm.Format("YYYY-MM-DD HH:mm z Z"), "2024-01-10 09:00 CST -06:00")
+
+	tm, err = date.ParseInLocation("YYYY-MM-DD HH:mm z", "2024-07-10 09:00 EDT", tzNY)
+	a.NilNow(err)
+	a.EqualNow(tm.Location().String(), "America/New_York")
+
+	tm, err = date.ParseInLocation("YYYY-MM-DD HH:mm z", "2024-01-10 09:00 +0530", time.UTC)
+	a.NilNow(err)
+	_, offset = tm.Zone()
+	a.EqualNow(offset, 5*3600+30*60)
+
+	_, err = date.Parse("YYYY-MM-DD HH:mm z", "2024-01-10 09:00 XYZ")
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), `parsing time "2024-01-10 09:00 XYZ" as "YYYY-MM-DD HH:mm z": `+
+		`cannot parse "z" as "XYZ": unknown time zone abbreviation`)
+
+	date.RestoreZoneAbbreviations(t)
+	date.RegisterZoneAbbreviation("XYZ", 3*3600)
+	tm, err = date.Parse("YYYY-MM-DD HH:mm z", "2024-01-10 09:00 XYZ")
+	a.NilNow(err)
+	_, offset = tm.Zone()
+	a.EqualNow(offset, 3*3600)
+
+	_, err = date.Parse("YYYY-MM-DD HH:mm z", "2024-01-10 09:00 ab")
+	a.NotNilNow(err)
+}
+
+func TestParseFractionRoundTrip(t *testing.T) {
+	a := assert.New(t)
+
+	layouts := []string{
+		"YYYY-MM-DD HH:mm:ss.SSSSSS",
+		"YYYY-MM-DD HH:mm:ss.SSSSSSSSS",
+		"YYYY-MM-DD HH:mm:ss.F",
+	}
+	tm := date.Date(2024, 1, 10, 9, 30, 15, 987654000, time.Local)
+
+	for _, layout := range layouts {
+		parsed, err := date.Parse(layout, tm.Format(layout))
+		a.NilNow(err)
+		a.TrueNow(parsed.Equal(tm))
+	}
+}
+
+func TestParseWithStdlibLayout(t *testing.T) {
+	a := assert.New(t)
+
+	tm, err := date.Parse(time.RFC3339, "2024-01-10T09:30:00.123456Z")
+	a.NilNow(err)
+	a.EqualNow(tm.Location(), time.UTC)
+	a.TrueNow(tm.Equal(time.Date(2024, 1, 10, 9, 30, 0, 123456000, time.UTC)))
+
+	tm, err = date.Parse(time.RFC3339Nano, "2024-01-10T09:30:00+08:00")
+	a.NilNow(err)
+	a.TrueNow(tm.Equal(time.Date(2024, 1, 10, 1, 30, 0, 0, time.UTC)))
+
+	tm, err = date.Parse("2006-01-02 15:04:05,000 -07", "2024-01-10 09:30:00,120 -03")
+	a.NilNow(err)
+	a.TrueNow(tm.Equal(time.Date(2024, 1, 10, 12, 30, 0, 120000000, time.UTC)))
+
+	tm, err = date.ParseInLocation("2006.002", "2024.060", time.UTC)
+	a.NilNow(err)
+	a.TrueNow(tm.Equal(time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)))
+
+	tm, err = date.ParseInLocation("2006 __2", "2024  32", time.UTC)
+	a.NilNow(err)
+	a.TrueNow(tm.Equal(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)))
+
+	tm, err = date.ParseInLocation("06-01-02", "69-01-02", time.UTC)
+	a.NilNow(err)
+	a.EqualNow(tm.Year(), 1969)
+
+	_, err = date.Parse("15:04:05.000", "09:30:00.12")
+	a.NotNilNow(err)
+
+	_, err = date.ParseStrict("2006.002", "2023.366")
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), `parsing time "2023.366" as "2006.002": cannot parse "002" as "366": `+
+		`day of year out of range 1..365`)
+
+	// the last day of the leap years
+	tm, err = date.ParseStrictInLocation("2006.002", "2024.366", time.UTC)
+	a.NilNow(err)
+	a.TrueNow(tm.Equal(time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)))
+
+	// the text after the end of the layout is rejected in strict mode, and ignored by Parse
+	_, err = date.Parse("3:04PM", "3:04PM extra")
+	a.NilNow(err)
+
+	_, err = date.ParseStrict("3:04PM", "3:04PM extra")
+	a.NotNilNow(err)
+	a.TrueNow(errors.Is(err, date.ErrUnexpectedText))
+	a.EqualNow(err.Error(), `parsing time "3:04PM extra" as "3:04PM": extra text: " extra"`)
+
+	var pe *date.ParseError
+	a.TrueNow(errors.As(err, &pe))
+	a.EqualNow(pe.Offset, 6)
+
+	// the unknown abbreviations of the reference layout are accepted like time.Parse
+	tm, err = date.Parse(time.RFC1123, "Wed, 10 Jan 2024 09:30:00 XYZ")
+	a.NilNow(err)
+	_, offset := tm.Zone()
+	a.EqualNow(offset, 0)
+}
+
+func TestParseReferenceZoneAbbr(t *testing.T) {
+	a := assert.New(t)
+
+	tzLA, _ := time.LoadLocation("America/Los_Angeles")
+	tzBerlin, _ := time.LoadLocation("Europe/Berlin")
+
+	cases := []struct {
+		layout string
+		value  string
+		loc    *time.Location
+	}{
+		{time.RFC1123, "Wed, 10 Jul 2024 15:04:05 CEST", time.UTC},
+		{time.RFC1123, "Wed, 10 Jul 2024 15:04:05 CEST", tzBerlin},
+		{time.RFC1123, "Wed, 10 Jan 2024 15:04:05 CEST", tzBerlin},
+		{time.RFC1123, "Wed, 10 Jan 2024 15:04:05 GMT+3", time.UTC},
+		{time.RFC1123, "Wed, 10 Jan 2024 15:04:05 GMT-10", tzLA},
+		{time.RFC1123, "Wed, 10 Jan 2024 15:04:05 GMT", time.UTC},
+		{time.RFC1123, "Wed, 10 Jan 2024 15:04:05 UTC", tzLA},
+		{time.RFC1123, "Wed, 10 Jan 2024 15:04:05 XYZ", tzLA},
+		{time.RFC1123, "Wed, 10 Jan 2024 15:04:05 +08", time.UTC},
+		{time.RFC1123, "Wed, 10 Jan 2024 15:04:05 PST", tzLA},
+		{time.RFC1123, "Wed, 10 Jul 2024 15:04:05 PST", tzLA},
+		{time.RFC1123, "Wed, 10 Jul 2024 15:04:05 PDT", tzLA},
+		{time.UnixDate, "Wed Jan 10 15:04:05 EST 2024", time.UTC},
+		{"2006-01-02 15:04:05 -0700 MST", "2024-07-10 15:04:05 +0200 CEST", time.UTC},
+		{"2006-01-02 15:04:05 -0700 MST", "2024-07-10 15:04:05 +0200 CEST", tzBerlin},
+		{"2006-01-02 15:04:05 -0700 MST", "2024-07-10 15:04:05 +0100 CEST", tzBerlin},
+		{"2006-01-02 15:04:05 -0700 MST", "2024-07-10 15:04:05 +0800 UTC", tzBerlin},
+	}
+
+	for _, test := range cases {
+		expected, err := time.ParseInLocation(test.layout, test.value, test.loc)
+		a.NilNow(err)
+		tm, err := date.ParseInLocation(test.layout, test.value, test.loc)
+		a.NilNow(err, test.value)
+		a.TrueNow(tm.Equal(expected), "parse %q in %v", test.value, test.loc)
+
+		expectedName, expectedOffset := expected.Zone()
+		name, offset := tm.Zone()
+		a.EqualNow(name, expectedName, "parse %q in %v", test.value, test.loc)
+		a.EqualNow(offset, expectedOffset, "parse %q in %v", test.value, test.loc)
+		a.EqualNow(tm.Location().String(), expected.Location().String(), test.value)
+	}
+}
+
+func TestParseUnix(t *testing.T) {
+	a := assert.New(t)
+
+	cases := []struct {
+		expect time.Time
+		layout string
+		str    string
+	}{
+		{time.Unix(1704931200, 0), "X", "1704931200"},
+		{time.Unix(1704931200, 123000000), "X", "1704931200.123"},
+		{time.Unix(1704931200, 123456789), "X", "1704931200,1234567891"},
+		{time.Unix(-2, 500000000), "X", "-1.5"},
+		{time.UnixMilli(1704931200123), "x", "1704931200123"},
+		{time.UnixMilli(-1500), "x", "-1500"},
+		{time.Unix(1704931200, 123500000), "x", "1704931200123.5"},
+		{time.Unix(1704931200, 120000000), "X.SS", "1704931200.12"},
+		{time.Unix(1704931200, 0), "[id=]D [ts=]X", "id=42 ts=1704931200"},
+	}
+
+	for _, test := range cases {
+		tm, err := date.Parse(test.layout, test.str)
+		a.NilNow(err)
+		a.TrueNow(tm.Equal(test.expect))
+	}
+
+	tm, err := date.ParseInLocation("X", "1704931200", time.UTC)
+	a.NilNow(err)
+	a.EqualNow(tm.Location(), time.UTC)
+
+	tm, err = date.Parse("X Z", "1704931200 +08:00")
+	a.NilNow(err)
+	a.TrueNow(tm.Equal(time.Unix(1704931200, 0)))
+	a.EqualNow(tm.Format("YYYY-MM-DD HH:mm Z"), "2024-01-11 08:00 +08:00")
+
+	_, err = date.Parse("X", "ts")
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(),
+		`parsing time "ts" as "X": cannot parse "X" as "t": expected Unix timestamp`)
+
+	_, err = date.Parse("X", "99999999999999999999")
+	a.NotNilNow(err)
+	a.TrueNow(errors.Is(err, date.ErrRange))
+	a.EqualNow(err.Error(), `parsing time "99999999999999999999" as "X": `+
+		`cannot parse "X" as "99999999999999999999": Unix timestamp out of range`)
+}
+
+func TestUnixRoundTrip(t *testing.T) {
+	a := assert.New(t)
+
+	cases := []struct {
+		tm     time.Time
+		layout string
+	}{
+		{time.Unix(1704931200, 123000000), "X.SSS"},
+		{time.Unix(-2, 500000000), "X.SSS"},
+		{time.Unix(-1, 750000000), "X.999"},
+		{time.Unix(-1, 750000000), "x"},
+		{time.Unix(-86400, 0), "X"},
+		{time.Unix(-86400, 0), "X.SSS"},
+	}
+
+	for _, test := range cases {
+		str := date.New(test.tm).Format(test.layout)
+		parsed, err := date.Parse(test.layout, str)
+		a.NilNow(err, "parse %q with %q", str, test.layout)
+		a.TrueNow(parsed.Equal(test.tm), "parse %q with %q", str, test.layout)
+	}
+}
+
+func TestParseMulti(t *testing.T) {
+	a := assert.New(t)
+
+	layouts := []string{"YYYY-MM-DD", "DD/MM/YYYY", "MMM D, YYYY"}
+
+	tm, layout, err := date.ParseMulti(layouts, "10/01/2024")
+	a.NilNow(err)
+	a.EqualNow(layout, "DD/MM/YYYY")
+	a.TrueNow(tm.Equal(date.Date(2024, 1, 10, 0, 0, 0, 0, time.Local)))
+
+	tm, layout, err = date.ParseMultiInLocation(layouts, "Jan 10, 2024", time.UTC)
+	a.NilNow(err)
+	a.EqualNow(layout, "MMM D, YYYY")
+	a.TrueNow(tm.Equal(time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)))
+
+	_, layout, err = date.ParseMulti(layouts[:2], "Jan 10, 2024")
+	a.NotNilNow(err)
+	a.EqualNow(layout, "")
+	a.EqualNow(err.Error(), `parsing time "Jan 10, 2024": no layout matched: `+
+		`parsing time "Jan 10, 2024" as "YYYY-MM-DD": cannot parse "YYYY" as "J": `+
+		`expected 4-digit year; `+
+		`parsing time "Jan 10, 2024" as "DD/MM/YYYY": cannot parse "DD" as "J": expected 2-digit day`)
+
+	var me *date.MultiParseError
+	a.TrueNow(errors.As(err, &me))
+	a.EqualNow(len(me.Errors), 2)
+
+	var pe *date.ParseError
+	a.TrueNow(errors.As(err, &pe))
+	a.EqualNow(pe.Layout, "YYYY-MM-DD")
+	a.TrueNow(errors.Is(err, me.Errors[1]))
+
+	_, _, err = date.ParseMulti(nil, "2024-01-10")
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), `parsing time "2024-01-10": no layout`)
+	a.NotTrueNow(errors.As(err, &pe))
+}
@@ -0,0 +1,412 @@
+package date
+
+import (
+	"strings"
+	"time"
+)
+
+// parseAnyOptions is the options of parsing the time in an unknown layout.
+type parseAnyOptions struct {
+	loc      *time.Location
+	dayFirst bool
+}
+
+// ParseAnyOption is the option of ParseAny.
+type ParseAnyOption func(*parseAnyOptions)
+
+// AnyDayFirst resolves the ambiguous numeric dates like "01/02/2024" as day-first (2 January
+// 2024) instead of month-first (1 February 2024).
+func AnyDayFirst() ParseAnyOption {
+	return func(opts *parseAnyOptions) {
+		opts.dayFirst = true
+	}
+}
+
+// AnyMonthFirst resolves the ambiguous numeric dates like "01/02/2024" as month-first (1 February
+// 2024), it's the default policy.
+func AnyMonthFirst() ParseAnyOption {
+	return func(opts *parseAnyOptions) {
+		opts.dayFirst = false
+	}
+}
+
+// AnyLocation sets the location of the time without time zone information, default time.Local.
+func AnyLocation(loc *time.Location) ParseAnyOption {
+	return func(opts *parseAnyOptions) {
+		opts.loc = loc
+	}
+}
+
+// ParseAny detects the layout of the value and parses it, and returns the time and the detected
+// layout. The layout can be cached and used by Parse for the values in the same shape.
+//
+// It supports the common shapes of the timestamps, for example "2024-01-10T09:30:00Z" (RFC 3339),
+// "2024/01/10", "01/10/2024", "Jan 10, 2024 3:04 PM", "Wed, 10 Jan 2024 15:04:05 +0000"
+// (RFC 2822), "Wed Jan 10 15:04:05 MST 2024", "20240110", and the Unix timestamps in seconds or
+// milliseconds. The numeric dates that the day and the month cannot be told apart are resolved
+// by the ambiguity policy, month-first by default. The value is parsed in strict mode like
+// ParseStrict, and it returns ErrUnknownFormat if the layout of the value cannot be detected, for
+// example the date without the year like "Jan 10", or the sign without the offset after the time.
+func ParseAny(value string, opts ...ParseAnyOption) (Time, string, error) {
+	options := parseAnyOptions{loc: time.Local}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	layout, ok := detectLayout(value, options.dayFirst)
+	if !ok {
+		return Time{}, "", ErrUnknownFormat
+	}
+
+	tm, err := getLayout(layout).parse(value, parseOptions{
+		loc:    options.loc,
+		locale: English,
+		strict: true,
+	})
+	if err != nil {
+		return Time{}, layout, err
+	}
+
+	return tm, layout, nil
+}
+
+// anySegment is a run of the digits, the letters, or a single other character of the value.
+type anySegment struct {
+	// kind is 'd' for the digits, 'a' for the letters, or the character itself.
+	kind byte
+	text string
+}
+
+// splitAnySegments splits the value into the runs of digits, the runs of letters, and the other
+// characters.
+func splitAnySegments(value string) []anySegment {
+	segments := make([]anySegment, 0, 16)
+
+	for i := 0; i < len(value); {
+		c := value[i]
+		j := i + 1
+		kind := c
+		if isDigit(c) {
+			kind = 'd'
+			for ; j < len(value) && isDigit(value[j]); j++ {
+			}
+		} else if isLetter(c) {
+			kind = 'a'
+			for ; j < len(value) && isLetter(value[j]); j++ {
+			}
+		}
+		segments = append(segments, anySegment{kind: kind, text: value[i:j]})
+		i = j
+	}
+
+	return segments
+}
+
+// detectCompactLayout returns the layout of the value that has only digits, like the Unix
+// timestamps or "20240110".
+func detectCompactLayout(segments []anySegment) (string, bool) {
+	if len(segments) == 3 && segments[1].kind == '.' && segments[2].kind == 'd' {
+		// Unix timestamp in seconds with the fractional seconds
+		if n := len(segments[0].text); n == 9 || n == 10 {
+			return "X", true
+		}
+		return "", false
+	}
+
+	switch len(segments[0].text) {
+	case 8:
+		return "YYYYMMDD", true
+	case 9, 10:
+		return "X", true
+	case 12:
+		return "YYYYMMDDHHmm", true
+	case 13:
+		return "x", true
+	case 14:
+		return "YYYYMMDDHHmmss", true
+	default:
+		return "", false
+	}
+}
+
+// detectLayout returns the layout of the value by the shape of the value.
+func detectLayout(value string, dayFirst bool) (string, bool) {
+	segments := splitAnySegments(value)
+	if len(segments) == 0 {
+		return "", false
+	}
+	if segments[0].kind == 'd' && (len(segments) == 1 ||
+		(len(segments) == 3 && segments[1].kind == '.')) {
+		return detectCompactLayout(segments)
+	}
+
+	roles := make([]string, len(segments))
+	var dates []int // the indexes of the numbers of the date that are not resolved
+	yearIdx, hourIdx, timeEnd := -1, -1, -1
+	hasMonth, hasMeridiem := false, false
+
+	for i := 0; i < len(segments); i++ {
+		seg := segments[i]
+
+		switch seg.kind {
+		case 'd':
+			if hourIdx < 0 && len(seg.text) <= 2 && i+2 < len(segments) &&
+				segments[i+1].kind == ':' && segments[i+2].kind == 'd' {
+				hourIdx = i
+				i = detectTimeLayout(segments, roles, i)
+				timeEnd = i
+			} else if len(seg.text) == 4 && yearIdx < 0 {
+				roles[i], yearIdx = "YYYY", i
+			} else if len(seg.text) <= 2 && i+1 < len(segments) && isOrdinalSuffix(segments[i+1]) {
+				roles[i], roles[i+1] = "Do", ""
+				i++
+			} else if len(seg.text) <= 2 {
+				dates = append(dates, i)
+			} else {
+				return "", false
+			}
+		case 'a':
+			role, ok := detectWordLayout(seg.text, timeEnd >= 0 && i > timeEnd)
+			if !ok {
+				return "", false
+			}
+			switch role {
+			case "MMM", "MMMM":
+				hasMonth = true
+			case "A", "a":
+				hasMeridiem = true
+			}
+			roles[i] = role
+		case '+', '-':
+			if timeEnd >= 0 && i > timeEnd {
+				// the sign after the time must begin an offset
+				role, n := "", 0
+				if i+1 < len(segments) && segments[i+1].kind == 'd' &&
+					(i == timeEnd+1 || segments[i-1].kind == ' ') {
+					role, n = detectOffsetLayout(segments[i:])
+				}
+				if n == 0 {
+					return "", false
+				}
+				roles[i] = role
+				i += n - 1
+				break
+			}
+			roles[i] = seg.text
+		case '[', '\\', '_':
+			roles[i] = "\\" + seg.text
+		default:
+			roles[i] = seg.text
+		}
+	}
+
+	if yearIdx < 0 && hourIdx < 0 && !hasMonth && len(dates) == 0 {
+		// no date or time in the value
+		return "", false
+	}
+	if !resolveDateLayout(segments, roles, dates, yearIdx, hasMonth, dayFirst) {
+		return "", false
+	}
+	if (hasMonth || len(dates) > 0) && !hasYearLayout(roles) {
+		// the date without the year like "Jan" or "01/10"
+		return "", false
+	}
+	if hasMeridiem && hourIdx >= 0 {
+		roles[hourIdx] = strings.ToLower(roles[hourIdx])
+	}
+
+	return strings.Join(roles, ""), true
+}
+
+// detectTimeLayout sets the roles of the time that begins at the index, like "15:04",
+// "15:04:05", or "15:04:05.000", and returns the index of the last segment of the time.
+func detectTimeLayout(segments []anySegment, roles []string, i int) int {
+	roles[i] = strings.Repeat("H", len(segments[i].text))
+	roles[i+1], roles[i+2] = ":", timeNumLayout(segments[i+2], "m")
+	i += 2
+
+	if i+2 < len(segments) && segments[i+1].kind == ':' && segments[i+2].kind == 'd' {
+		roles[i+1], roles[i+2] = ":", timeNumLayout(segments[i+2], "s")
+		i += 2
+
+		if i+2 < len(segments) && (segments[i+1].kind == '.' || segments[i+1].kind == ',') &&
+			segments[i+2].kind == 'd' && len(segments[i+2].text) <= 9 {
+			roles[i+1] = segments[i+1].text + strings.Repeat("0", len(segments[i+2].text))
+			roles[i+2] = ""
+			i += 2
+		}
+	}
+
+	return i
+}
+
+// timeNumLayout returns the layout of the minutes or the seconds, it's the single letter for the
+// single digit like "1:1:1", or the double letters.
+func timeNumLayout(seg anySegment, role string) string {
+	if len(seg.text) == 1 {
+		return role
+	}
+	return role + role
+}
+
+// detectOffsetLayout returns the layout of the timezone offset like "+08:00", "+0800", or "+08",
+// and the number of the segments of the offset.
+func detectOffsetLayout(segments []anySegment) (string, int) {
+	switch n := len(segments[1].text); {
+	case n == 2 && len(segments) >= 4 && segments[2].kind == ':' && segments[3].kind == 'd' &&
+		len(segments[3].text) == 2:
+		return "-07:00", 4
+	case n == 2:
+		return "-07", 2
+	case n == 4:
+		return "-0700", 2
+	default:
+		return "", 0
+	}
+}
+
+// detectWordLayout returns the layout of the word, it's a weekday name, a month name, a meridiem
+// marker, a timezone abbreviation after the time, or the literal text.
+func detectWordLayout(word string, afterTime bool) (string, bool) {
+	if isWordOf(fullWeekdayNames, word) {
+		return "dddd", true
+	} else if isWordOf(abbrWeekdayNames, word) {
+		return "ddd", true
+	} else if isWordOf(fullMonthNames, word) {
+		return "MMMM", true
+	} else if isWordOf(abbrMonthNames, word) {
+		return "MMM", true
+	}
+
+	switch word {
+	case "AM", "PM":
+		return "A", true
+	case "am", "pm":
+		return "a", true
+	case "T":
+		return "[T]", true
+	case "Z":
+		if afterTime {
+			return "Z07:00", true
+		}
+	}
+
+	if afterTime && len(word) >= 3 && len(word) <= 5 && strings.ToUpper(word) == word {
+		return "z", true
+	}
+
+	return "[" + word + "]", true
+}
+
+// isWordOf reports whether the word is one of the names in the list, ignoring the case.
+func isWordOf(list []string, word string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveDateLayout sets the roles of the numbers of the date, and it returns false if the
+// numbers cannot be resolved.
+func resolveDateLayout(
+	segments []anySegment,
+	roles []string,
+	dates []int,
+	yearIdx int,
+	hasMonth, dayFirst bool,
+) bool {
+	numRole := func(i int, role string) {
+		if len(segments[i].text) == 2 {
+			role += role
+		}
+		roles[i] = role
+	}
+
+	if hasMonth {
+		switch {
+		case len(dates) == 1:
+			numRole(dates[0], "D")
+		case len(dates) == 2 && yearIdx < 0:
+			numRole(dates[0], "D")
+			roles[dates[1]] = "YY"
+		case len(dates) == 0:
+		default:
+			return false
+		}
+		return true
+	}
+
+	switch {
+	case len(dates) == 0:
+		return true
+	case len(dates) == 1 && yearIdx >= 0 && yearIdx < dates[0]:
+		numRole(dates[0], "M")
+		return true
+	case len(dates) == 2 && yearIdx >= 0 && yearIdx < dates[0]:
+		// year-first dates are always year, month, and day
+		numRole(dates[0], "M")
+		numRole(dates[1], "D")
+		return true
+	case len(dates) == 2:
+	case len(dates) == 3 && yearIdx < 0 && len(segments[dates[2]].text) == 2:
+		roles[dates[2]] = "YY"
+	default:
+		return false
+	}
+
+	first, second := atoi(segments[dates[0]].text), atoi(segments[dates[1]].text)
+	if first > 12 || (dayFirst && second <= 12) {
+		numRole(dates[0], "D")
+		numRole(dates[1], "M")
+	} else {
+		numRole(dates[0], "M")
+		numRole(dates[1], "D")
+	}
+
+	return true
+}
+
+// hasYearLayout reports whether one of the roles is the year.
+func hasYearLayout(roles []string) bool {
+	for _, role := range roles {
+		if role == "YYYY" || role == "YY" {
+			return true
+		}
+	}
+	return false
+}
+
+// isOrdinalSuffix reports whether the segment is an English ordinal suffix like "st" or "th".
+func isOrdinalSuffix(seg anySegment) bool {
+	if seg.kind != 'a' {
+		return false
+	}
+
+	switch strings.ToLower(seg.text) {
+	case "st", "nd", "rd", "th":
+		return true
+	default:
+		return false
+	}
+}
+
+// isDigit reports whether the character is a digit.
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// isLetter reports whether the character is an ASCII letter.
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// atoi converts the digits to the integer without checking.
+func atoi(s string) int {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		n = n*10 + int(s[i]-'0')
+	}
+	return n
+}
@@ -0,0 +1,125 @@
+package date_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ghosind/go-assert"
+	"github.com/ghosind/go-date"
+)
+
+func TestParseAny(t *testing.T) {
+	a := assert.New(t)
+
+	cases := []struct {
+		str    string
+		layout string
+		expect time.Time
+	}{
+		{
+			"2024-01-10T09:30:00Z", "YYYY-MM-DD[T]HH:mm:ssZ07:00",
+			time.Date(2024, 1, 10, 9, 30, 0, 0, time.UTC),
+		},
+		{
+			"2024-01-10T09:30:00.123456+08:00", "YYYY-MM-DD[T]HH:mm:ss.000000-07:00",
+			time.Date(2024, 1, 10, 1, 30, 0, 123456000, time.UTC),
+		},
+		{
+			"2024-01-10 09:30:00 +0800 CST", "YYYY-MM-DD HH:mm:ss -0700 z",
+			time.Date(2024, 1, 10, 1, 30, 0, 0, time.UTC),
+		},
+		{"2024/01/10", "YYYY/MM/DD", time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)},
+		{"2024-01", "YYYY-MM", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"01/10/2024", "MM/DD/YYYY", time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)},
+		{"13/01/2024", "DD/MM/YYYY", time.Date(2024, 1, 13, 0, 0, 0, 0, time.UTC)},
+		{"1/13/24", "M/DD/YY", time.Date(2024, 1, 13, 0, 0, 0, 0, time.UTC)},
+		{
+			"Jan 10, 2024 3:04 PM", "MMM DD, YYYY h:mm A",
+			time.Date(2024, 1, 10, 15, 4, 0, 0, time.UTC),
+		},
+		{"January 1st, 2024", "MMMM Do, YYYY", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"10 Jan 2024", "DD MMM YYYY", time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)},
+		{
+			"Wed, 10 Jan 2024 15:04:05 +0000", "ddd, DD MMM YYYY HH:mm:ss -0700",
+			time.Date(2024, 1, 10, 15, 4, 5, 0, time.UTC),
+		},
+		{
+			"Wed Jan 10 15:04:05 MST 2024", "ddd MMM DD HH:mm:ss z YYYY",
+			time.Date(2024, 1, 10, 22, 4, 5, 0, time.UTC),
+		},
+		{
+			"Tue Jan  2 15:04:05 2024", "ddd MMM  D HH:mm:ss YYYY",
+			time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+		{
+			"Wednesday, 10-Jan-24 15:04:05 UTC", "dddd, DD-MMM-YY HH:mm:ss z",
+			time.Date(2024, 1, 10, 15, 4, 5, 0, time.UTC),
+		},
+		{
+			"2024-01-10 at 9:30", "YYYY-MM-DD [at] H:mm",
+			time.Date(2024, 1, 10, 9, 30, 0, 0, time.UTC),
+		},
+		{"3:04pm", "h:mma", time.Date(0, 1, 1, 15, 4, 0, 0, time.UTC)},
+		{"2024-1-1 1:1:1", "YYYY-M-D H:m:s", time.Date(2024, 1, 1, 1, 1, 1, 0, time.UTC)},
+		{"2024-1-1 1:01:1", "YYYY-M-D H:mm:s", time.Date(2024, 1, 1, 1, 1, 1, 0, time.UTC)},
+		{"20240110", "YYYYMMDD", time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)},
+		{"20240110153000", "YYYYMMDDHHmmss", time.Date(2024, 1, 10, 15, 30, 0, 0, time.UTC)},
+		{"1704931200", "X", time.Unix(1704931200, 0)},
+		{"1704931200.5", "X", time.Unix(1704931200, 500000000)},
+		{"1704931200123", "x", time.UnixMilli(1704931200123)},
+	}
+
+	for _, test := range cases {
+		tm, layout, err := date.ParseAny(test.str, date.AnyLocation(time.UTC))
+		a.NilNow(err)
+		a.EqualNow(layout, test.layout)
+		a.TrueNow(tm.Equal(test.expect))
+
+		// the detected layout parses the value in the same way
+		tm, err = date.ParseInLocation(layout, test.str, time.UTC)
+		a.NilNow(err)
+		a.TrueNow(tm.Equal(test.expect))
+	}
+}
+
+func TestParseAnyAmbiguity(t *testing.T) {
+	a := assert.New(t)
+
+	tm, layout, err := date.ParseAny("01/02/2024", date.AnyLocation(time.UTC))
+	a.NilNow(err)
+	a.EqualNow(layout, "MM/DD/YYYY")
+	a.TrueNow(tm.Equal(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)))
+
+	tm, layout, err = date.ParseAny("01/02/2024", date.AnyLocation(time.UTC), date.AnyDayFirst())
+	a.NilNow(err)
+	a.EqualNow(layout, "DD/MM/YYYY")
+	a.TrueNow(tm.Equal(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)))
+
+	tm, layout, err = date.ParseAny("01/13/2024", date.AnyDayFirst(), date.AnyMonthFirst())
+	a.NilNow(err)
+	a.EqualNow(layout, "MM/DD/YYYY")
+	a.EqualNow(tm.Location(), time.Local)
+
+	// the day that greater than 12 is not ambiguous
+	_, layout, err = date.ParseAny("01/13/2024", date.AnyDayFirst())
+	a.NilNow(err)
+	a.EqualNow(layout, "MM/DD/YYYY")
+}
+
+func TestParseAnyWithError(t *testing.T) {
+	a := assert.New(t)
+
+	for _, str := range []string{"", "hello", "12345", "2024-01-10-11-12", "1.2.3.4", "Jan",
+		"Jan 10", "01/10", "2024-01-10 10:00 +", "2024-01-10 10:00 - 11:00"} {
+		_, layout, err := date.ParseAny(str)
+		a.TrueNow(errors.Is(err, date.ErrUnknownFormat))
+		a.EqualNow(layout, "")
+	}
+
+	_, layout, err := date.ParseAny("2024-13-45")
+	a.NotNilNow(err)
+	a.EqualNow(layout, "YYYY-MM-DD")
+	a.EqualNow(err.Error(), `parsing time "2024-13-45" as "YYYY-MM-DD": cannot parse "MM" as "13": `+
+		`month out of range 1..12`)
+}
@@ -0,0 +1,289 @@
+package date
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RelativeTime holds the phrases of the relative time. The "%s" in Future and Past is replaced by
+// the phrase of the duration, and the "%d" in the plural phrases is replaced by the number. The
+// empty phrases use the English phrases.
+type RelativeTime struct {
+	// Future is the phrase of the future time, for example "in %s".
+	Future string
+	// Past is the phrase of the past time, for example "%s ago".
+	Past string
+	// FewSeconds is the phrase of a few seconds, for example "a few seconds".
+	FewSeconds string
+	// Seconds is the phrase of the seconds, for example "%d seconds".
+	Seconds string
+	// Minute is the phrase of a minute, for example "a minute".
+	Minute string
+	// Minutes is the phrase of the minutes, for example "%d minutes".
+	Minutes string
+	// Hour is the phrase of an hour, for example "an hour".
+	Hour string
+	// Hours is the phrase of the hours, for example "%d hours".
+	Hours string
+	// Day is the phrase of a day, for example "a day".
+	Day string
+	// Days is the phrase of the days, for example "%d days".
+	Days string
+	// Month is the phrase of a month, for example "a month".
+	Month string
+	// Months is the phrase of the months, for example "%d months".
+	Months string
+	// Year is the phrase of a year, for example "a year".
+	Year string
+	// Years is the phrase of the years, for example "%d years".
+	Years string
+}
+
+// englishRelativeTime is the phrases of the relative time in English.
+var englishRelativeTime = RelativeTime{
+	Future:     "in %s",
+	Past:       "%s ago",
+	FewSeconds: "a few seconds",
+	Seconds:    "%d seconds",
+	Minute:     "a minute",
+	Minutes:    "%d minutes",
+	Hour:       "an hour",
+	Hours:      "%d hours",
+	Day:        "a day",
+	Days:       "%d days",
+	Month:      "a month",
+	Months:     "%d months",
+	Year:       "a year",
+	Years:      "%d years",
+}
+
+// RelativeTimeThresholds holds the thresholds to choose the unit of the relative time. The
+// duration is rounded to the nearest number of each unit before comparing with the thresholds.
+// The zero fields are unset, and they use the default thresholds.
+type RelativeTimeThresholds struct {
+	// FewSeconds is the maximum seconds to be "a few seconds", default 44.
+	FewSeconds int
+	// Seconds is the seconds that less than it to be "%d seconds", default 45.
+	Seconds int
+	// Minutes is the minutes that less than it to be "%d minutes", default 45.
+	Minutes int
+	// Hours is the hours that less than it to be "%d hours", default 22.
+	Hours int
+	// Days is the days that less than it to be "%d days", default 26.
+	Days int
+	// Months is the months that less than it to be "%d months", default 11.
+	Months int
+}
+
+// defaultRelativeTimeThresholds is the built-in thresholds of the relative time, they're the same
+// as moment.js.
+var defaultRelativeTimeThresholds = RelativeTimeThresholds{
+	FewSeconds: 44,
+	Seconds:    45,
+	Minutes:    45,
+	Hours:      22,
+	Days:       26,
+	Months:     11,
+}
+
+var (
+	relativeTimeThresholdsMutex sync.RWMutex
+	relativeTimeThresholds      = defaultRelativeTimeThresholds
+)
+
+// SetRelativeTimeThresholds sets the default thresholds of the relative time, the zero fields use
+// the built-in thresholds.
+func SetRelativeTimeThresholds(thresholds RelativeTimeThresholds) {
+	relativeTimeThresholdsMutex.Lock()
+	defer relativeTimeThresholdsMutex.Unlock()
+
+	mergeRelativeTimeThresholds(&thresholds, defaultRelativeTimeThresholds)
+	relativeTimeThresholds = thresholds
+}
+
+// GetRelativeTimeThresholds returns the default thresholds of the relative time.
+func GetRelativeTimeThresholds() RelativeTimeThresholds {
+	relativeTimeThresholdsMutex.RLock()
+	defer relativeTimeThresholdsMutex.RUnlock()
+
+	return relativeTimeThresholds
+}
+
+// relativeTimeOptions is the options of formatting the relative time.
+type relativeTimeOptions struct {
+	locale       *Locale
+	thresholds   RelativeTimeThresholds
+	withoutAffix bool
+}
+
+// RelativeTimeOption is the option of formatting the relative time.
+type RelativeTimeOption func(*relativeTimeOptions)
+
+// RelativeLocale sets the locale of the phrases of the relative time, default English.
+func RelativeLocale(locale *Locale) RelativeTimeOption {
+	return func(opts *relativeTimeOptions) {
+		opts.locale = getLocale(locale)
+	}
+}
+
+// RelativeThresholds sets the thresholds to choose the unit of the relative time, instead of the
+// default thresholds. The zero fields use the default thresholds, so it can set only some of them.
+func RelativeThresholds(thresholds RelativeTimeThresholds) RelativeTimeOption {
+	return func(opts *relativeTimeOptions) {
+		mergeRelativeTimeThresholds(&thresholds, opts.thresholds)
+		opts.thresholds = thresholds
+	}
+}
+
+// RelativeWithoutAffix drops the "in" and "ago" affixes of the relative time, for example
+// "3 hours" instead of "3 hours ago".
+func RelativeWithoutAffix() RelativeTimeOption {
+	return func(opts *relativeTimeOptions) {
+		opts.withoutAffix = true
+	}
+}
+
+// FromNow returns the relative time from now to the time, for example "3 hours ago" for the time
+// that 3 hours before now, or "in 2 days" for the time that 2 days after now.
+func (t Time) FromNow(opts ...RelativeTimeOption) string {
+	return formatRelativeTime(t.Time.Sub(time.Now()), opts)
+}
+
+// From returns the relative time from u to the time, for example "3 hours ago" if the time is 3
+// hours before u. The parameter u must be a Time or time.Time, or it'll panic.
+func (t Time) From(u any, opts ...RelativeTimeOption) string {
+	tm := getTime(u)
+	return formatRelativeTime(t.Time.Sub(tm), opts)
+}
+
+// ToNow returns the relative time from the time to now, for example "in 3 hours" for the time
+// that 3 hours before now.
+func (t Time) ToNow(opts ...RelativeTimeOption) string {
+	return formatRelativeTime(time.Since(t.Time), opts)
+}
+
+// To returns the relative time from the time to u, for example "in 3 hours" if u is 3 hours
+// after the time. The parameter u must be a Time or time.Time, or it'll panic.
+func (t Time) To(u any, opts ...RelativeTimeOption) string {
+	tm := getTime(u)
+	return formatRelativeTime(tm.Sub(t.Time), opts)
+}
+
+// formatRelativeTime returns the phrase of the duration, the duration is positive for the future
+// time, and negative for the past time.
+func formatRelativeTime(d time.Duration, opts []RelativeTimeOption) string {
+	options := relativeTimeOptions{
+		locale:     English,
+		thresholds: GetRelativeTimeThresholds(),
+	}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	phrases := options.locale.RelativeTime
+	mergeRelativeTime(&phrases, englishRelativeTime)
+	th := options.thresholds
+
+	abs := d.Abs()
+	seconds := int(math.Round(abs.Seconds()))
+	minutes := int(math.Round(abs.Minutes()))
+	hours := int(math.Round(abs.Hours()))
+	days := int(math.Round(abs.Hours() / 24))
+	// 400 years have 146097 days (taking into account leap year rules)
+	months := int(math.Round(abs.Hours() / 24 * 4800 / 146097))
+	years := int(math.Round(abs.Hours() / 24 * 400 / 146097))
+
+	var str string
+	switch {
+	case seconds <= th.FewSeconds:
+		str = phrases.FewSeconds
+	case seconds < th.Seconds:
+		str = relativeTimePhrase(phrases.Seconds, seconds)
+	case minutes <= 1:
+		str = phrases.Minute
+	case minutes < th.Minutes:
+		str = relativeTimePhrase(phrases.Minutes, minutes)
+	case hours <= 1:
+		str = phrases.Hour
+	case hours < th.Hours:
+		str = relativeTimePhrase(phrases.Hours, hours)
+	case days <= 1:
+		str = phrases.Day
+	case days < th.Days:
+		str = relativeTimePhrase(phrases.Days, days)
+	case months <= 1:
+		str = phrases.Month
+	case months < th.Months:
+		str = relativeTimePhrase(phrases.Months, months)
+	case years <= 1:
+		str = phrases.Year
+	default:
+		str = relativeTimePhrase(phrases.Years, years)
+	}
+
+	if options.withoutAffix {
+		return str
+	} else if d > 0 {
+		return strings.Replace(phrases.Future, "%s", str, 1)
+	} else {
+		return strings.Replace(phrases.Past, "%s", str, 1)
+	}
+}
+
+// relativeTimePhrase replaces the "%d" in the phrase by the number.
+func relativeTimePhrase(phrase string, num int) string {
+	return strings.Replace(phrase, "%d", strconv.Itoa(num), 1)
+}
+
+// mergeRelativeTime sets the empty phrases of dst to the phrases of src.
+func mergeRelativeTime(dst *RelativeTime, src RelativeTime) {
+	fields := []struct {
+		dst *string
+		src string
+	}{
+		{&dst.Future, src.Future},
+		{&dst.Past, src.Past},
+		{&dst.FewSeconds, src.FewSeconds},
+		{&dst.Seconds, src.Seconds},
+		{&dst.Minute, src.Minute},
+		{&dst.Minutes, src.Minutes},
+		{&dst.Hour, src.Hour},
+		{&dst.Hours, src.Hours},
+		{&dst.Day, src.Day},
+		{&dst.Days, src.Days},
+		{&dst.Month, src.Month},
+		{&dst.Months, src.Months},
+		{&dst.Year, src.Year},
+		{&dst.Years, src.Years},
+	}
+
+	for _, field := range fields {
+		if *field.dst == "" {
+			*field.dst = field.src
+		}
+	}
+}
+
+// mergeRelativeTimeThresholds sets the zero thresholds of dst to the thresholds of src.
+func mergeRelativeTimeThresholds(dst *RelativeTimeThresholds, src RelativeTimeThresholds) {
+	fields := []struct {
+		dst *int
+		src int
+	}{
+		{&dst.FewSeconds, src.FewSeconds},
+		{&dst.Seconds, src.Seconds},
+		{&dst.Minutes, src.Minutes},
+		{&dst.Hours, src.Hours},
+		{&dst.Days, src.Days},
+		{&dst.Months, src.Months},
+	}
+
+	for _, field := range fields {
+		if *field.dst == 0 {
+			*field.dst = field.src
+		}
+	}
+}
@@ -0,0 +1,121 @@
+package date_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ghosind/go-assert"
+	"github.com/ghosind/go-date"
+)
+
+func TestRelativeTime(t *testing.T) {
+	a := assert.New(t)
+
+	base := date.Date(2024, time.January, 10, 12, 0, 0, 0)
+	cases := []struct {
+		d      time.Duration
+		expect string
+	}{
+		{0, "a few seconds ago"},
+		{30 * time.Second, "in a few seconds"},
+		{-44 * time.Second, "a few seconds ago"},
+		{-45 * time.Second, "a minute ago"},
+		{89 * time.Second, "in a minute"},
+		{-90 * time.Second, "2 minutes ago"},
+		{-44 * time.Minute, "44 minutes ago"},
+		{-45 * time.Minute, "an hour ago"},
+		{-89 * time.Minute, "an hour ago"},
+		{-90 * time.Minute, "2 hours ago"},
+		{3 * time.Hour, "in 3 hours"},
+		{-21 * time.Hour, "21 hours ago"},
+		{-22 * time.Hour, "a day ago"},
+		{-35 * time.Hour, "a day ago"},
+		{-36 * time.Hour, "2 days ago"},
+		{2 * 24 * time.Hour, "in 2 days"},
+		{-25 * 24 * time.Hour, "25 days ago"},
+		{-26 * 24 * time.Hour, "a month ago"},
+		{-45 * 24 * time.Hour, "a month ago"},
+		{-46 * 24 * time.Hour, "2 months ago"},
+		{-319 * 24 * time.Hour, "10 months ago"},
+		{-320 * 24 * time.Hour, "a year ago"},
+		{-547 * 24 * time.Hour, "a year ago"},
+		{-548 * 24 * time.Hour, "2 years ago"},
+		{5 * 365 * 24 * time.Hour, "in 5 years"},
+	}
+
+	for _, test := range cases {
+		a.EqualNow(base.Add(test.d).From(base), test.expect, "duration %v", test.d)
+		a.EqualNow(base.To(base.Add(test.d)), test.expect, "duration %v", test.d)
+	}
+}
+
+func TestRelativeTimeFromNow(t *testing.T) {
+	a := assert.New(t)
+
+	a.EqualNow(date.Now().Add(-3*time.Hour).FromNow(), "3 hours ago")
+	a.EqualNow(date.Now().Add(2*24*time.Hour+time.Minute).FromNow(), "in 2 days")
+	a.EqualNow(date.Now().Add(-3*time.Hour).ToNow(), "in 3 hours")
+	a.EqualNow(date.Now().Add(3*time.Hour+time.Minute).ToNow(), "3 hours ago")
+}
+
+func TestRelativeTimeOptions(t *testing.T) {
+	a := assert.New(t)
+
+	base := time.Date(2024, time.January, 10, 12, 0, 0, 0, time.UTC)
+	tm := date.New(base.Add(-30 * time.Second))
+
+	a.EqualNow(tm.From(base, date.RelativeWithoutAffix()), "a few seconds")
+	a.EqualNow(tm.From(base, date.RelativeThresholds(date.RelativeTimeThresholds{
+		FewSeconds: 10,
+		Seconds:    45,
+		Minutes:    45,
+		Hours:      22,
+		Days:       26,
+		Months:     11,
+	})), "30 seconds ago")
+
+	french := &date.Locale{
+		RelativeTime: date.RelativeTime{
+			Future:     "dans %s",
+			Past:       "il y a %s",
+			FewSeconds: "quelques secondes",
+			Hours:      "%d heures",
+		},
+	}
+	a.EqualNow(tm.From(base, date.RelativeLocale(french)), "il y a quelques secondes")
+	a.EqualNow(
+		date.New(base).From(base.Add(-3*time.Hour), date.RelativeLocale(french)),
+		"dans 3 heures",
+	)
+	// the phrases that the locale does not have are in English
+	a.EqualNow(
+		date.New(base).From(base.Add(2*24*time.Hour), date.RelativeLocale(french)),
+		"il y a 2 days",
+	)
+
+	// the thresholds that are not set use the default thresholds
+	partial := date.RelativeThresholds(date.RelativeTimeThresholds{FewSeconds: 10})
+	a.EqualNow(tm.From(base, partial), "30 seconds ago")
+	a.EqualNow(date.New(base.Add(-3*time.Hour)).From(base, partial), "3 hours ago")
+
+	a.PanicOfNow(func() { tm.From(1) }, date.ErrNotTime)
+}
+
+func TestSetRelativeTimeThresholds(t *testing.T) {
+	a := assert.New(t)
+
+	thresholds := date.GetRelativeTimeThresholds()
+	defer date.SetRelativeTimeThresholds(thresholds)
+
+	base := date.Date(2024, time.January, 10, 12, 0, 0, 0)
+	a.EqualNow(base.Add(-23*time.Hour).From(base), "a day ago")
+
+	newThresholds := thresholds
+	newThresholds.Hours = 24
+	date.SetRelativeTimeThresholds(newThresholds)
+	a.EqualNow(date.GetRelativeTimeThresholds(), newThresholds)
+	a.EqualNow(base.Add(-23*time.Hour).From(base), "23 hours ago")
+
+	date.SetRelativeTimeThresholds(date.RelativeTimeThresholds{Hours: 24})
+	a.EqualNow(date.GetRelativeTimeThresholds(), newThresholds)
+}
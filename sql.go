@@ -0,0 +1,83 @@
+package date
+
+import (
+	"database/sql/driver"
+	"sync"
+	"time"
+)
+
+// defaultScanLayouts is the default layouts of scanning the time strings from the databases, they
+// cover the text forms of the time in PostgreSQL, MySQL, and SQLite.
+var defaultScanLayouts = []string{
+	DefaultMarshalLayout,
+	"YYYY-MM-DD HH:mm:ss.999999999Z07:00",
+	"YYYY-MM-DD HH:mm:ss.999999999Z07",
+	"YYYY-MM-DD HH:mm:ss.999999999",
+	"YYYY-MM-DD",
+}
+
+var (
+	scanLayoutsMutex sync.RWMutex
+	scanLayouts      = defaultScanLayouts
+)
+
+// SetScanLayouts sets the layouts of scanning the time strings from the databases, they're tried
+// in order like ParseMulti. It resets the layouts to the default layouts if no layout is provided.
+func SetScanLayouts(layouts ...string) {
+	scanLayoutsMutex.Lock()
+	defer scanLayoutsMutex.Unlock()
+
+	if len(layouts) == 0 {
+		scanLayouts = defaultScanLayouts
+		return
+	}
+	scanLayouts = append([]string(nil), layouts...)
+}
+
+// ScanLayouts returns the layouts of scanning the time strings from the databases.
+func ScanLayouts() []string {
+	scanLayoutsMutex.RLock()
+	defer scanLayoutsMutex.RUnlock()
+
+	return append([]string(nil), scanLayouts...)
+}
+
+// Scan implements the sql.Scanner interface. It accepts time.Time, the strings and the bytes in
+// the scan layouts, and the Unix timestamps in seconds (int64). The strings without time zone
+// information are in UTC, and a nil value is the zero time.
+func (t *Time) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*t = Time{}
+	case time.Time:
+		t.Time = v
+	case Time:
+		*t = v
+	case int64:
+		*t = Unix(v, 0)
+	case string:
+		return t.scanString(v)
+	case []byte:
+		return t.scanString(string(v))
+	default:
+		return ErrUnsupportedType
+	}
+
+	return nil
+}
+
+// scanString parses the string in the scan layouts.
+func (t *Time) scanString(value string) error {
+	tm, _, err := ParseMultiInLocation(ScanLayouts(), value, time.UTC)
+	if err != nil {
+		return err
+	}
+	*t = tm
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface, the value is a time.Time.
+func (t Time) Value() (driver.Value, error) {
+	return t.Time, nil
+}
@@ -0,0 +1,172 @@
+package date_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/ghosind/go-assert"
+	"github.com/ghosind/go-date"
+)
+
+// echoDriver is a fake database driver, its queries return a row of the arguments, and its
+// executions keep the arguments.
+type echoDriver struct {
+	args []driver.Value
+}
+
+func (d *echoDriver) Open(string) (driver.Conn, error) {
+	return &echoConn{driver: d}, nil
+}
+
+type echoConn struct {
+	driver *echoDriver
+}
+
+func (c *echoConn) Prepare(string) (driver.Stmt, error) {
+	return &echoStmt{driver: c.driver}, nil
+}
+
+func (c *echoConn) Close() error {
+	return nil
+}
+
+func (c *echoConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+type echoStmt struct {
+	driver *echoDriver
+}
+
+func (s *echoStmt) Close() error {
+	return nil
+}
+
+func (s *echoStmt) NumInput() int {
+	return -1
+}
+
+func (s *echoStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.driver.args = args
+	return driver.RowsAffected(1), nil
+}
+
+func (s *echoStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &echoRows{values: args}, nil
+}
+
+type echoRows struct {
+	values []driver.Value
+	done   bool
+}
+
+func (r *echoRows) Columns() []string {
+	columns := make([]string, len(r.values))
+	for i := range columns {
+		columns[i] = "v"
+	}
+	return columns
+}
+
+func (r *echoRows) Close() error {
+	return nil
+}
+
+func (r *echoRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	copy(dest, r.values)
+	r.done = true
+	return nil
+}
+
+var echo = &echoDriver{}
+
+func init() {
+	sql.Register("date-echo", echo)
+}
+
+func TestTimeScan(t *testing.T) {
+	a := assert.New(t)
+	loc := time.FixedZone("UTC+8", 8*60*60)
+
+	db, err := sql.Open("date-echo", "")
+	a.NilNow(err)
+	defer db.Close()
+
+	cases := []struct {
+		value  any
+		expect time.Time
+	}{
+		{
+			time.Date(2024, 1, 10, 9, 30, 15, 0, loc),
+			time.Date(2024, 1, 10, 9, 30, 15, 0, loc),
+		},
+		{"2024-01-10T09:30:15.123+08:00", time.Date(2024, 1, 10, 9, 30, 15, 123000000, loc)},
+		{"2024-01-10 09:30:15.123456+08", time.Date(2024, 1, 10, 9, 30, 15, 123456000, loc)},
+		{"2024-01-10 09:30:15+08:00", time.Date(2024, 1, 10, 9, 30, 15, 0, loc)},
+		{"2024-01-10 09:30:15", time.Date(2024, 1, 10, 9, 30, 15, 0, time.UTC)},
+		{[]byte("2024-01-10 09:30:15"), time.Date(2024, 1, 10, 9, 30, 15, 0, time.UTC)},
+		{"2024-01-10", time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)},
+		{int64(1704879015), time.Date(2024, 1, 10, 9, 30, 15, 0, time.UTC)},
+		{nil, time.Time{}},
+	}
+
+	for _, c := range cases {
+		var tm date.Time
+		a.NilNow(db.QueryRow("SELECT ?", c.value).Scan(&tm), c.value)
+		a.TrueNow(tm.Equal(date.Time{Time: c.expect}), c.value, tm)
+
+		var nt date.NullTime
+		a.NilNow(db.QueryRow("SELECT ?", c.value).Scan(&nt), c.value)
+		a.EqualNow(nt.Valid, c.value != nil, c.value)
+		a.TrueNow(nt.Equal(date.Time{Time: c.expect}), c.value, nt)
+	}
+
+	var tm date.Time
+	err = db.QueryRow("SELECT ?", 1.5).Scan(&tm)
+	a.TrueNow(errors.Is(err, date.ErrUnsupportedType))
+
+	err = db.QueryRow("SELECT ?", "10/01/2024").Scan(&tm)
+	var me *date.MultiParseError
+	a.TrueNow(errors.As(err, &me))
+
+	nt := date.NullTime{Valid: true}
+	a.NotNilNow(db.QueryRow("SELECT ?", "10/01/2024").Scan(&nt))
+	a.NotTrueNow(nt.Valid)
+}
+
+func TestSetScanLayouts(t *testing.T) {
+	a := assert.New(t)
+	defer date.SetScanLayouts()
+
+	var tm date.Time
+	a.NotNilNow(tm.Scan("10/01/2024"))
+
+	date.SetScanLayouts("DD/MM/YYYY")
+	a.EqualNow(date.ScanLayouts(), []string{"DD/MM/YYYY"})
+	a.NilNow(tm.Scan("10/01/2024"))
+	a.TrueNow(tm.Equal(date.Date(2024, time.January, 10, 0, 0, 0, 0)))
+
+	date.SetScanLayouts()
+	a.EqualNow(len(date.ScanLayouts()), 5)
+	a.NotNilNow(tm.Scan("10/01/2024"))
+}
+
+func TestTimeValue(t *testing.T) {
+	a := assert.New(t)
+
+	db, err := sql.Open("date-echo", "")
+	a.NilNow(err)
+	defer db.Close()
+
+	tm := date.Date(2024, time.January, 10, 9, 30, 15, 0)
+	_, err = db.Exec("INSERT", tm, date.NullTime{Time: tm, Valid: true}, date.NullTime{})
+	a.NilNow(err)
+	a.EqualNow(echo.args, []driver.Value{tm.Time, tm.Time, nil})
+}
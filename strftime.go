@@ -0,0 +1,195 @@
+package date
+
+import "time"
+
+// strftimeDirectives is the layout tokens of the strftime conversion specifications.
+var strftimeDirectives = map[byte]int{
+	'a': layoutTokenDayOfWeekAbbr,
+	'A': layoutTokenDayOfWeekFull,
+	'b': layoutTokenMonthAbbr,
+	'B': layoutTokenMonthFull,
+	'C': layoutTokenCentury,
+	'd': layoutTokenDayLong,
+	'e': layoutTokenDaySpace,
+	'G': layoutTokenISOWeekYearLong,
+	'g': layoutTokenISOWeekYear,
+	'h': layoutTokenMonthAbbr,
+	'H': layoutTokenHourLong,
+	'I': layoutTokenHour12Long,
+	'j': layoutTokenDayOfYearLong,
+	'k': layoutTokenHourSpace,
+	'l': layoutTokenHour12Space,
+	'm': layoutTokenMonthLong,
+	'M': layoutTokenMinuteLong,
+	'p': layoutTokenPMUpper,
+	'P': layoutTokenPMLower,
+	's': layoutTokenUnix,
+	'S': layoutTokenSecondLong,
+	'u': layoutTokenISOWeekday,
+	'U': layoutTokenWeekSunday,
+	'V': layoutTokenISOWeekLong,
+	'w': layoutTokenDayOfWeek,
+	'W': layoutTokenWeekMonday,
+	'y': layoutTokenYear,
+	'Y': layoutTokenYearLong,
+	'z': layoutTokenTZ,
+	'Z': layoutTokenTZAbbr,
+}
+
+// strftimeComposites is the equivalent formats of the composite conversion specifications, they
+// are the formats of the POSIX locale.
+var strftimeComposites = map[byte]string{
+	'c': "%a %b %e %H:%M:%S %Y",
+	'D': "%m/%d/%y",
+	'F': "%Y-%m-%d",
+	'r': "%I:%M:%S %p",
+	'R': "%H:%M",
+	'T': "%H:%M:%S",
+	'x': "%m/%d/%y",
+	'X': "%H:%M:%S",
+}
+
+// strftimeLiterals is the characters of the conversion specifications that represent a character.
+var strftimeLiterals = map[byte]string{
+	'n': "\n",
+	't': "\t",
+	'%': "%",
+}
+
+// strftimeUnpadded is the tokens of the variable-width numbers for the padded tokens, they're used
+// for the conversion specifications with a padding flag to accept the numbers in any padding.
+var strftimeUnpadded = map[int]int{
+	layoutTokenMonthLong:     layoutTokenMonth,
+	layoutTokenDayLong:       layoutTokenDay,
+	layoutTokenDaySpace:      layoutTokenDay,
+	layoutTokenHourLong:      layoutTokenHour,
+	layoutTokenHourSpace:     layoutTokenHour,
+	layoutTokenHour12Long:    layoutTokenHour12,
+	layoutTokenHour12Space:   layoutTokenHour12,
+	layoutTokenMinuteLong:    layoutTokenMinute,
+	layoutTokenSecondLong:    layoutTokenSecond,
+	layoutTokenDayOfYearLong: layoutTokenDayOfYear,
+	layoutTokenISOWeekLong:   layoutTokenISOWeek,
+}
+
+// strftimeLayoutCache is the LRU cache of the compiled strftime formats.
+var strftimeLayoutCache = newLayoutCache(layoutCacheSize, compileStrftime)
+
+// Strftime returns a string of the time formatted by the strftime format, for example
+// "%Y-%m-%d %H:%M:%S %z". It supports the conversion specifications of POSIX and glibc, and the
+// "-" (no padding), "_" (padding with spaces), and "0" (padding with zeros) flags of the numeric
+// conversions, for example "%-d" or "%_H". The unknown conversion specifications are copied to the
+// result as they are.
+func (t Time) Strftime(format string) string {
+	buf := make([]byte, 0, 64)
+	buf = strftimeLayoutCache.get(format).appendFormat(buf, t, English)
+
+	return string(buf)
+}
+
+// Strptime parses a formatted string with the strftime format and returns the time value it
+// represents. It's the reverse of Strftime, and the time is in the local time zone if the value
+// has no time zone information.
+//
+// The numeric conversion specifications without a flag require the padded numbers like Strftime
+// returns, and the ones with a flag accept the numbers with or without the padding.
+func Strptime(format, value string) (Time, error) {
+	return StrptimeInLocation(format, value, time.Local)
+}
+
+// StrptimeInLocation is like Strptime but uses the location for the time without time zone
+// information.
+func StrptimeInLocation(format, value string, loc *time.Location) (Time, error) {
+	return strftimeLayoutCache.get(format).parse(value, parseOptions{loc: loc, locale: English})
+}
+
+// compileStrftime splits the strftime format into tokens and returns the compiled Layout.
+func compileStrftime(format string) *Layout {
+	l := &Layout{layout: format}
+
+	for str := format; len(str) > 0; {
+		i := 0
+		for ; i < len(str) && str[i] != '%'; i++ {
+		}
+		if i > 0 {
+			l.tokens = append(l.tokens, layoutToken{kind: layoutTokenNone, value: str[0:i]})
+			str = str[i:]
+			continue
+		}
+
+		var pad byte
+		n := 1
+		if len(str) > 2 && (str[1] == padNone || str[1] == padSpace || str[1] == padZero) {
+			pad = str[1]
+			n++
+		}
+		if len(str) <= n {
+			l.tokens = append(l.tokens, layoutToken{kind: layoutTokenNone, value: str})
+			break
+		}
+
+		c, spec := str[n], str[0:n+1]
+		str = str[n+1:]
+
+		if token, ok := strftimeDirectives[c]; ok {
+			if unpadded, ok := strftimeUnpadded[token]; ok && pad != 0 {
+				token = unpadded
+			}
+			l.tokens = append(l.tokens, layoutToken{kind: token, value: spec, pad: pad})
+		} else if composite, ok := strftimeComposites[c]; ok {
+			l.tokens = append(l.tokens, compileStrftime(composite).tokens...)
+		} else if literal, ok := strftimeLiterals[c]; ok {
+			l.tokens = append(l.tokens, layoutToken{kind: layoutTokenNone, value: literal})
+		} else {
+			l.tokens = append(l.tokens, layoutToken{kind: layoutTokenNone, value: spec})
+		}
+	}
+
+	return l
+}
+
+// layoutNumber returns the value and the default width of the numeric token, it returns false if
+// the token is not a numeric token.
+func layoutNumber(token int, t Time) (int, int, bool) {
+	switch token {
+	case layoutTokenYearLong:
+		return t.Year(), 4, true
+	case layoutTokenYear:
+		return t.Year() % 100, 2, true
+	case layoutTokenCentury:
+		return t.Year() / 100, 2, true
+	case layoutTokenMonth, layoutTokenMonthLong:
+		return int(t.Month()), 2, true
+	case layoutTokenDay, layoutTokenDayLong, layoutTokenDaySpace:
+		return t.Day(), 2, true
+	case layoutTokenHour, layoutTokenHourLong, layoutTokenHourSpace:
+		return t.Hour(), 2, true
+	case layoutTokenHour12, layoutTokenHour12Long, layoutTokenHour12Space:
+		return t.Hour12(), 2, true
+	case layoutTokenMinute, layoutTokenMinuteLong:
+		return t.Minute(), 2, true
+	case layoutTokenSecond, layoutTokenSecondLong:
+		return t.Second(), 2, true
+	case layoutTokenDayOfYear, layoutTokenDayOfYearLong, layoutTokenDayOfYearSpace:
+		return t.YearDay(), 3, true
+	case layoutTokenDayOfWeek:
+		return int(t.Weekday()), 1, true
+	case layoutTokenISOWeekday:
+		return (int(t.Weekday())+6)%7 + 1, 1, true
+	case layoutTokenISOWeek, layoutTokenISOWeekLong:
+		_, week := t.ISOWeek()
+		return week, 2, true
+	case layoutTokenISOWeekYear:
+		year, _ := t.ISOWeek()
+		return year % 100, 2, true
+	case layoutTokenISOWeekYearLong:
+		year, _ := t.ISOWeek()
+		return year, 4, true
+	case layoutTokenWeekSunday:
+		return weekOfYear(t, time.Sunday), 2, true
+	case layoutTokenWeekMonday:
+		return weekOfYear(t, time.Monday), 2, true
+	default:
+		return 0, 0, false
+	}
+}
@@ -0,0 +1,129 @@
+package date_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ghosind/go-assert"
+	"github.com/ghosind/go-date"
+)
+
+func TestStrftime(t *testing.T) {
+	a := assert.New(t)
+
+	tm := date.Date(2024, time.January, 5, 3, 4, 5, 0, time.FixedZone("CST", 8*3600))
+
+	cases := []struct {
+		format string
+		expect string
+	}{
+		{"%Y-%m-%d %H:%M:%S %z %Z", "2024-01-05 03:04:05 +0800 CST"},
+		{"%a %A %b %h %B", "Fri Friday Jan Jan January"},
+		{"%C %y %d %e %j %I %l %k %p %P", "20 24 05  5 005 03  3  3 AM am"},
+		{"%u %w %U %W %V %G %g", "5 5 00 01 01 2024 24"},
+		{"%s", "1704395045"},
+		{"%c", "Fri Jan  5 03:04:05 2024"},
+		{"%D %F %R %T %r", "01/05/24 2024-01-05 03:04 03:04:05 03:04:05 AM"},
+		{"%x %X", "01/05/24 03:04:05"},
+		{"%n%t%%", "\n\t%"},
+		{"%-d %-m %-H %-j %-e", "5 1 3 5 5"},
+		{"%_d %_m %_H %_j", " 5  1  3   5"},
+		{"%0e %0k %0l", "05 03 03"},
+		{"%Q %", "%Q %"},
+		{"100%", "100%"},
+	}
+
+	for _, test := range cases {
+		a.EqualNow(tm.Strftime(test.format), test.expect)
+	}
+
+	a.EqualNow(date.Date(2024, time.December, 31, 23, 0, 0, 0).Strftime("%U %W %-H %-M"),
+		"52 53 23 0")
+}
+
+func TestStrptime(t *testing.T) {
+	a := assert.New(t)
+
+	cases := []struct {
+		expect date.Time
+		format string
+		str    string
+	}{
+		{
+			date.Date(2024, 1, 5, 3, 4, 5, 0, time.Local),
+			"%Y-%m-%d %H:%M:%S", "2024-01-05 03:04:05",
+		},
+		{
+			date.Date(2024, 1, 5, 3, 4, 5, 0, time.Local),
+			"%c", "Fri Jan  5 03:04:05 2024",
+		},
+		{date.Date(2024, 1, 5, 3, 0, 0, 0, time.Local), "%-d/%-m/%Y %_H", "5/1/2024  3"},
+		{date.Date(2024, 1, 5, 0, 0, 0, 0, time.Local), "%e %B %Y", " 5 January 2024"},
+		{date.Date(2024, 1, 5, 0, 0, 0, 0, time.Local), "%C%y-%j", "2024-005"},
+		{date.Date(2024, 1, 1, 0, 0, 0, 0, time.Local), "%j %Y", "366 2023"},
+		{date.Date(2023, 12, 31, 0, 0, 0, 0, time.Local), "%j %Y", "000 2024"},
+		{date.Date(1900, 1, 1, 0, 0, 0, 0, time.Local), "%C", "19"},
+		{date.Date(2024, 1, 6, 0, 0, 0, 0, time.Local), "%Y %U %a", "2024 00 Sat"},
+		{date.Date(2024, 1, 7, 0, 0, 0, 0, time.Local), "%Y %U %a", "2024 01 Sun"},
+		{date.Date(2024, 1, 1, 0, 0, 0, 0, time.Local), "%Y %W %w", "2024 01 1"},
+		{date.Date(2023, 1, 1, 0, 0, 0, 0, time.Local), "%Y %W", "2023 00"},
+		{date.Date(2024, 3, 6, 0, 0, 0, 0, time.Local), "%Y %W %u", "2024 10 3"},
+		{date.Date(2024, 3, 10, 0, 0, 0, 0, time.Local), "%Y %W %u", "2024 10 7"},
+		{date.Date(2024, 3, 13, 0, 0, 0, 0, time.Local), "%Y %U %u", "2024 10 3"},
+		{date.Date(2024, 3, 10, 0, 0, 0, 0, time.Local), "%Y %U %u", "2024 10 7"},
+		{date.Date(2024, 1, 16, 0, 0, 0, 0, time.Local), "%G-W%V-%u", "2024-W03-2"},
+		{date.Date(0, 1, 1, 13, 30, 0, 0, time.Local), "%l:%M %P", " 1:30 pm"},
+		{date.Date(0, 1, 1, 0, 30, 0, 0, time.Local), "%I:%M %p", "12:30 AM"},
+		{date.Date(2024, 1, 1, 0, 0, 0, 0, time.Local), "100%% %Y", "100% 2024"},
+		{date.Unix(1704395045, 0), "%s", "1704395045"},
+		{
+			date.Date(2024, 1, 5, 3, 4, 5, 0, time.FixedZone("", 8*3600)),
+			"%F %T %z", "2024-01-05 03:04:05 +0800",
+		},
+	}
+
+	for _, test := range cases {
+		tm, err := date.Strptime(test.format, test.str)
+		a.NilNow(err)
+		a.TrueNow(tm.Equal(test.expect))
+	}
+
+	tm, err := date.StrptimeInLocation("%F", "2024-01-05", time.UTC)
+	a.NilNow(err)
+	a.TrueNow(tm.Equal(time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)))
+	a.EqualNow(tm.Location(), time.UTC)
+}
+
+func TestStrptimeWithError(t *testing.T) {
+	a := assert.New(t)
+
+	cases := []struct {
+		format        string
+		str           string
+		expectedError string
+	}{
+		{
+			"%Y-%m-%d", "2024-1-05",
+			`parsing time "2024-1-05" as "%Y-%m-%d": cannot parse "%m" as "1-": expected 2-digit month`,
+		},
+		{
+			"%H:%M", "03-04",
+			`parsing time "03-04" as "%H:%M": cannot parse ":" as "-": expected ":"`,
+		},
+		{
+			"%s", "x",
+			`parsing time "x" as "%s": cannot parse "%s" as "x": expected Unix timestamp`,
+		},
+	}
+
+	for _, test := range cases {
+		_, err := date.Strptime(test.format, test.str)
+		a.NotNilNow(err)
+		a.EqualNow(err.Error(), test.expectedError)
+
+		var pe *date.ParseError
+		a.TrueNow(errors.As(err, &pe))
+		a.EqualNow(pe.Layout, test.format)
+	}
+}
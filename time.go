@@ -209,6 +209,13 @@ func (t Time) StartOfYear() Time {
 	return tm
 }
 
+// StartOfISOWeekYear returns the start time of the ISO 8601 week-based year, it's the Monday of
+// the first week that has the first Thursday of the year.
+func (t Time) StartOfISOWeekYear() Time {
+	year, _ := t.ISOWeek()
+	return startOfISOWeekYear(year, t.Location())
+}
+
 // StartOfHalfYear returns the start time of the half year.
 func (t Time) StartOfHalfYear() Time {
 	month := t.Month()
@@ -232,6 +239,27 @@ func (t Time) StartOfMonth() Time {
 	return tm
 }
 
+// StartOfWeek returns the start time of the week, the week begins at the default first day of
+// week, it's Monday (ISO 8601) unless it's changed by SetFirstDayOfWeek.
+func (t Time) StartOfWeek() Time {
+	return t.StartOfWeekFrom(FirstDayOfWeek())
+}
+
+// StartOfWeekFrom returns the start time of the week that begins at the specific weekday.
+func (t Time) StartOfWeekFrom(first time.Weekday) Time {
+	y, m, d := t.Date()
+	d -= daysSinceWeekday(t.Weekday(), first)
+	tm := Date(y, m, d, 0, 0, 0, 0, t.Location())
+	return tm
+}
+
+// StartOfWeekLocale returns the start time of the week that begins at the first day of week of
+// the locale, or the default first day of week if the locale does not set it. It uses the English
+// locale if the locale is nil.
+func (t Time) StartOfWeekLocale(locale *Locale) Time {
+	return t.StartOfWeekFrom(getLocale(locale).firstDayOfWeek())
+}
+
 // StartOfDay returns the start time of the day.
 func (t Time) StartOfDay() Time {
 	y, m, d := t.Date()
@@ -264,6 +292,14 @@ func (t Time) EndOfYear() Time {
 	return tm
 }
 
+// EndOfISOWeekYear returns the end time of the ISO 8601 week-based year, it's the end of the
+// Sunday of the last week of the year.
+func (t Time) EndOfISOWeekYear() Time {
+	year, _ := t.ISOWeek()
+	tm := startOfISOWeekYear(year+1, t.Location()).Add(-time.Nanosecond)
+	return tm
+}
+
 // EndOfHalfYear returns the end time of the half year.
 func (t Time) EndOfHalfYear() Time {
 	month := t.Month()
@@ -287,6 +323,27 @@ func (t Time) EndOfMonth() Time {
 	return tm
 }
 
+// EndOfWeek returns the end time of the week, the week begins at the default first day of week,
+// it's Monday (ISO 8601) unless it's changed by SetFirstDayOfWeek.
+func (t Time) EndOfWeek() Time {
+	return t.EndOfWeekFrom(FirstDayOfWeek())
+}
+
+// EndOfWeekFrom returns the end time of the week that begins at the specific weekday.
+func (t Time) EndOfWeekFrom(first time.Weekday) Time {
+	y, m, d := t.Date()
+	d += 7 - daysSinceWeekday(t.Weekday(), first)
+	tm := Date(y, m, d, 0, 0, 0, 0, t.Location()).Add(-time.Nanosecond)
+	return tm
+}
+
+// EndOfWeekLocale returns the end time of the week that begins at the first day of week of the
+// locale, or the default first day of week if the locale does not set it. It uses the English
+// locale if the locale is nil.
+func (t Time) EndOfWeekLocale(locale *Locale) Time {
+	return t.EndOfWeekFrom(getLocale(locale).firstDayOfWeek())
+}
+
 // EndOfDay returns the end time of the day.
 func (t Time) EndOfDay() Time {
 	y, m, d := t.Date()
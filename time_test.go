@@ -321,6 +321,22 @@ func TestStartOfYear(t *testing.T) {
 		Equal(date.Date(2006, 1, 1, 0, 0, 0, 0, tzLA)))
 }
 
+func TestStartOfISOWeekYear(t *testing.T) {
+	a := assert.New(t)
+
+	tzLA, _ := time.LoadLocation("America/Los_Angeles")
+
+	a.TrueNow(date.Date(2006, 2, 2, 15, 4, 5, 0).
+		StartOfISOWeekYear().
+		Equal(date.Date(2006, 1, 2, 0, 0, 0, 0)))
+	a.TrueNow(date.Date(2010, 1, 2, 15, 4, 5, 0).
+		StartOfISOWeekYear().
+		Equal(date.Date(2008, 12, 29, 0, 0, 0, 0)))
+	a.TrueNow(date.Date(2008, 12, 30, 15, 4, 5, 0, tzLA).
+		StartOfISOWeekYear().
+		Equal(date.Date(2008, 12, 29, 0, 0, 0, 0, tzLA)))
+}
+
 func TestStartOfHalfYear(t *testing.T) {
 	a := assert.New(t)
 
@@ -368,6 +384,64 @@ func TestStartOfMonth(t *testing.T) {
 		Equal(date.Date(2006, 2, 1, 0, 0, 0, 0, tzLA)))
 }
 
+func TestStartOfWeek(t *testing.T) {
+	a := assert.New(t)
+
+	tzLA, _ := time.LoadLocation("America/Los_Angeles")
+
+	a.TrueNow(date.Date(2006, 2, 2, 15, 4, 5, 0).
+		StartOfWeek().
+		Equal(date.Date(2006, 1, 30, 0, 0, 0, 0)))
+	a.TrueNow(date.Date(2006, 2, 5, 15, 4, 5, 0).
+		StartOfWeek().
+		Equal(date.Date(2006, 1, 30, 0, 0, 0, 0)))
+	a.TrueNow(date.Date(2006, 1, 30, 0, 0, 0, 0, tzLA).
+		StartOfWeek().
+		Equal(date.Date(2006, 1, 30, 0, 0, 0, 0, tzLA)))
+}
+
+func TestStartOfWeekFrom(t *testing.T) {
+	a := assert.New(t)
+
+	tzLA, _ := time.LoadLocation("America/Los_Angeles")
+
+	a.TrueNow(date.Date(2006, 2, 2, 15, 4, 5, 0).
+		StartOfWeekFrom(time.Sunday).
+		Equal(date.Date(2006, 1, 29, 0, 0, 0, 0)))
+	a.TrueNow(date.Date(2006, 2, 2, 15, 4, 5, 0).
+		StartOfWeekFrom(time.Saturday).
+		Equal(date.Date(2006, 1, 28, 0, 0, 0, 0)))
+	a.TrueNow(date.Date(2006, 2, 2, 15, 4, 5, 0).
+		StartOfWeekFrom(time.Thursday).
+		Equal(date.Date(2006, 2, 2, 0, 0, 0, 0)))
+	a.TrueNow(date.Date(2006, 2, 2, 15, 4, 5, 0, tzLA).
+		StartOfWeekFrom(time.Friday).
+		Equal(date.Date(2006, 1, 27, 0, 0, 0, 0, tzLA)))
+}
+
+func TestStartOfWeekLocale(t *testing.T) {
+	a := assert.New(t)
+
+	a.TrueNow(date.Date(2006, 2, 2, 15, 4, 5, 0).
+		StartOfWeekLocale(nil).
+		Equal(date.Date(2006, 1, 29, 0, 0, 0, 0)))
+	a.TrueNow(date.Date(2006, 2, 2, 15, 4, 5, 0).
+		StartOfWeekLocale(french).
+		Equal(date.Date(2006, 1, 30, 0, 0, 0, 0)))
+
+	// the locale without the first day of week uses the default first day of week
+	unset := &date.Locale{Name: "xx"}
+	a.TrueNow(date.Date(2006, 2, 2, 15, 4, 5, 0).
+		StartOfWeekLocale(unset).
+		Equal(date.Date(2006, 1, 30, 0, 0, 0, 0)))
+
+	date.SetFirstDayOfWeek(time.Saturday)
+	defer date.SetFirstDayOfWeek(time.Monday)
+	a.TrueNow(date.Date(2006, 2, 2, 15, 4, 5, 0).
+		StartOfWeekLocale(unset).
+		Equal(date.Date(2006, 1, 28, 0, 0, 0, 0)))
+}
+
 func TestStartOfDay(t *testing.T) {
 	a := assert.New(t)
 
@@ -433,6 +507,17 @@ func TestEndOfYear(t *testing.T) {
 		Equal(date.Date(2006, 12, 31, 23, 59, 59, 999999999, tzLA)))
 }
 
+func TestEndOfISOWeekYear(t *testing.T) {
+	a := assert.New(t)
+
+	a.TrueNow(date.Date(2006, 2, 2, 15, 4, 5, 0).
+		EndOfISOWeekYear().
+		Equal(date.Date(2006, 12, 31, 23, 59, 59, 999999999)))
+	a.TrueNow(date.Date(2009, 6, 2, 15, 4, 5, 0).
+		EndOfISOWeekYear().
+		Equal(date.Date(2010, 1, 3, 23, 59, 59, 999999999)))
+}
+
 func TestEndOfHalfYear(t *testing.T) {
 	a := assert.New(t)
 
@@ -484,6 +569,46 @@ func TestEndOfMonth(t *testing.T) {
 		Equal(date.Date(2006, 6, 30, 23, 59, 59, 999999999)))
 }
 
+func TestEndOfWeek(t *testing.T) {
+	a := assert.New(t)
+
+	tzLA, _ := time.LoadLocation("America/Los_Angeles")
+
+	a.TrueNow(date.Date(2006, 2, 2, 15, 4, 5, 0).
+		EndOfWeek().
+		Equal(date.Date(2006, 2, 5, 23, 59, 59, 999999999)))
+	a.TrueNow(date.Date(2006, 2, 5, 15, 4, 5, 0, tzLA).
+		EndOfWeek().
+		Equal(date.Date(2006, 2, 5, 23, 59, 59, 999999999, tzLA)))
+}
+
+func TestEndOfWeekFrom(t *testing.T) {
+	a := assert.New(t)
+
+	tzLA, _ := time.LoadLocation("America/Los_Angeles")
+
+	a.TrueNow(date.Date(2006, 2, 2, 15, 4, 5, 0).
+		EndOfWeekFrom(time.Sunday).
+		Equal(date.Date(2006, 2, 4, 23, 59, 59, 999999999)))
+	a.TrueNow(date.Date(2006, 2, 2, 15, 4, 5, 0).
+		EndOfWeekFrom(time.Friday).
+		Equal(date.Date(2006, 2, 2, 23, 59, 59, 999999999)))
+	a.TrueNow(date.Date(2006, 2, 2, 15, 4, 5, 0, tzLA).
+		EndOfWeekFrom(time.Saturday).
+		Equal(date.Date(2006, 2, 3, 23, 59, 59, 999999999, tzLA)))
+}
+
+func TestEndOfWeekLocale(t *testing.T) {
+	a := assert.New(t)
+
+	a.TrueNow(date.Date(2006, 2, 2, 15, 4, 5, 0).
+		EndOfWeekLocale(nil).
+		Equal(date.Date(2006, 2, 4, 23, 59, 59, 999999999)))
+	a.TrueNow(date.Date(2006, 2, 2, 15, 4, 5, 0).
+		EndOfWeekLocale(french).
+		Equal(date.Date(2006, 2, 5, 23, 59, 59, 999999999)))
+}
+
 func TestEndOfDay(t *testing.T) {
 	a := assert.New(t)
 
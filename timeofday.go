@@ -0,0 +1,277 @@
+package date
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+)
+
+// timeOfDayLayout is the layout of the string form and the encodings of the time of day, the
+// fractional second is omitted if it's zero.
+const timeOfDayLayout = "HH:mm:ss.999999999"
+
+// timeOfDayShortLayout is the layout of the time of day without the seconds, it's accepted by the
+// decodings.
+const timeOfDayShortLayout = "HH:mm"
+
+// clockLayoutCache is the LRU cache of the compiled layouts of the times of day.
+var clockLayoutCache = newLayoutCache(layoutCacheSize, compileClockLayout)
+
+// TimeOfDay is a wall-clock time without the date and the location, for example the opening hours
+// of a store.
+type TimeOfDay struct {
+	Hour       int
+	Minute     int
+	Second     int
+	Nanosecond int
+}
+
+// NewTimeOfDay returns the time of day of the hour, the minute, the second, and the nanosecond.
+// The values are normalized and wrap around midnight, for example 25:00 is converted to 01:00.
+func NewTimeOfDay(hour, min, sec, nsec int) TimeOfDay {
+	d := time.Duration(hour)*time.Hour + time.Duration(min)*time.Minute +
+		time.Duration(sec)*time.Second + time.Duration(nsec)
+	return timeOfDayOfDuration(d)
+}
+
+// TimeOfDayOf returns the wall-clock time of the time in the location of the time.
+func TimeOfDayOf(t Time) TimeOfDay {
+	hour, min, sec := t.Clock()
+	return TimeOfDay{Hour: hour, Minute: min, Second: sec, Nanosecond: t.Nanosecond()}
+}
+
+// timeOfDayOfDuration returns the time of day of the duration since midnight, it wraps around
+// midnight.
+func timeOfDayOfDuration(d time.Duration) TimeOfDay {
+	const day = 24 * time.Hour
+	d %= day
+	if d < 0 {
+		d += day
+	}
+
+	return TimeOfDay{
+		Hour:       int(d / time.Hour),
+		Minute:     int(d % time.Hour / time.Minute),
+		Second:     int(d % time.Minute / time.Second),
+		Nanosecond: int(d % time.Second),
+	}
+}
+
+// ParseTimeOfDay parses a formatted string with the layout and returns the time of day it
+// represents. The layout supports the clock tokens only, and the other tokens like "YYYY" are the
+// literal text. The values out of the range like "25:00" are rejected with ErrRange.
+func ParseTimeOfDay(layout, value string) (TimeOfDay, error) {
+	opts := parseOptions{loc: time.UTC, locale: English, strict: true}
+	tm, err := clockLayoutCache.get(layout).parse(value, opts)
+	if err != nil {
+		return TimeOfDay{}, err
+	}
+
+	return TimeOfDayOf(tm), nil
+}
+
+// compileClockLayout compiles the layout, and converts the tokens that are not the clock tokens to
+// the literal text.
+func compileClockLayout(layout string) *Layout {
+	l := compileLayout(layout)
+
+	for i, tok := range l.tokens {
+		if !isClockToken(tok.kind) {
+			l.tokens[i] = layoutToken{kind: layoutTokenNone, value: tok.value}
+		}
+	}
+
+	return l
+}
+
+// isClockToken reports whether the token is a part of the wall-clock time.
+func isClockToken(token int) bool {
+	switch token {
+	case layoutTokenHour, layoutTokenHourLong, layoutTokenHourSpace,
+		layoutTokenHour12, layoutTokenHour12Long, layoutTokenHour12Space,
+		layoutTokenMinute, layoutTokenMinuteLong, layoutTokenSecond, layoutTokenSecondLong,
+		layoutTokenMillisecondHundred, layoutTokenMillisecondTen, layoutTokenMillisecond,
+		layoutTokenMicrosecond, layoutTokenNanosecond, layoutTokenFraction,
+		layoutTokenFractionFixed, layoutTokenFractionTrim,
+		layoutTokenPMUpper, layoutTokenPMLower:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns the time of day in the form of "HH:mm:ss", and the fractional second if it's not
+// zero.
+func (t TimeOfDay) String() string {
+	return t.Format(timeOfDayLayout)
+}
+
+// Format returns a string of the time of day formatted by the layout. The layout supports the
+// clock tokens only, and the other tokens like "YYYY" are copied to the result as they are.
+func (t TimeOfDay) Format(layout string) string {
+	buf := make([]byte, 0, 32)
+	buf = clockLayoutCache.get(layout).appendFormat(buf, t.On(CivilDate{2000, 1, 1}, time.UTC),
+		English)
+
+	return string(buf)
+}
+
+// IsValid reports whether the fields are in the range, for example 24:00 is not valid.
+func (t TimeOfDay) IsValid() bool {
+	return t.Hour >= 0 && t.Hour < 24 && t.Minute >= 0 && t.Minute < 60 &&
+		t.Second >= 0 && t.Second < 60 && t.Nanosecond >= 0 && t.Nanosecond < int(time.Second)
+}
+
+// SinceMidnight returns the duration since midnight of the time of day.
+func (t TimeOfDay) SinceMidnight() time.Duration {
+	return time.Duration(t.Hour)*time.Hour + time.Duration(t.Minute)*time.Minute +
+		time.Duration(t.Second)*time.Second + time.Duration(t.Nanosecond)
+}
+
+// Add returns the time of day t+d, it wraps around midnight, for example 23:00 plus 2 hours is
+// 01:00.
+func (t TimeOfDay) Add(d time.Duration) TimeOfDay {
+	return timeOfDayOfDuration(t.SinceMidnight() + d)
+}
+
+// Compare compares the times of day, it returns -1 if the time of day is before the other one, 1
+// if it's after the other one, and 0 if they're the same.
+func (t TimeOfDay) Compare(other TimeOfDay) int {
+	d, o := t.SinceMidnight(), other.SinceMidnight()
+	switch {
+	case d < o:
+		return -1
+	case d > o:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Before reports whether the time of day is before the other one.
+func (t TimeOfDay) Before(other TimeOfDay) bool {
+	return t.Compare(other) < 0
+}
+
+// After reports whether the time of day is after the other one.
+func (t TimeOfDay) After(other TimeOfDay) bool {
+	return t.Compare(other) > 0
+}
+
+// On returns the time of the time of day on the date in the location. The date is a CivilDate, or
+// a Time or time.Time that the date is in its location, and it panics if the date is not one of
+// them.
+//
+// If the wall-clock time does not exist on the date because of a daylight saving time transition
+// (a gap), it's moved forward by the length of the gap, for example 02:30 is 03:30 when the clocks
+// skip from 02:00 to 03:00. If the wall-clock time occurs twice (an overlap), it's the earlier
+// one.
+func (t TimeOfDay) On(date any, loc *time.Location) Time {
+	var d CivilDate
+	if cd, ok := date.(CivilDate); ok {
+		d = cd
+	} else {
+		d = CivilDateOf(New(getTime(date)))
+	}
+
+	// the wall-clock time as if it's in UTC
+	wall := time.Date(d.Year, d.Month, d.Day, t.Hour, t.Minute, t.Second, t.Nanosecond, time.UTC)
+
+	// the offsets around the wall-clock time, there is at most one transition between them
+	_, before := wall.Add(-24 * time.Hour).In(loc).Zone()
+	_, after := wall.Add(24 * time.Hour).In(loc).Zone()
+
+	var found bool
+	var tm time.Time
+	for _, offset := range []int{before, after} {
+		// the instant is valid if the location has the same offset at it, and the earlier one is
+		// used in an overlap
+		instant := wall.Add(-time.Duration(offset) * time.Second).In(loc)
+		if _, o := instant.Zone(); o == offset && (!found || instant.Before(tm)) {
+			tm, found = instant, true
+		}
+	}
+
+	if !found {
+		// in a gap, the offset before the transition moves the time forward
+		tm = wall.Add(-time.Duration(before) * time.Second).In(loc)
+	}
+
+	return New(tm)
+}
+
+// MarshalJSON implements the json.Marshaler interface, the time of day is a quoted string in the
+// form of "HH:mm:ss".
+func (t TimeOfDay) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, the time of day is a quoted string in
+// the form of "HH:mm:ss", "HH:mm:ss.SSS", or "HH:mm". A null value is a no-op, and an empty string is
+// midnight.
+func (t *TimeOfDay) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+
+	return t.UnmarshalText([]byte(str))
+}
+
+// MarshalText implements the encoding.TextMarshaler interface, the time of day is in the form of
+// "HH:mm:ss".
+func (t TimeOfDay) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface, the time of day is in the form
+// of "HH:mm:ss", "HH:mm:ss.SSS", or "HH:mm". An empty text is midnight.
+func (t *TimeOfDay) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*t = TimeOfDay{}
+		return nil
+	}
+
+	parsed, err := ParseTimeOfDay(timeOfDayLayout, string(data))
+	if err != nil {
+		short, shortErr := ParseTimeOfDay(timeOfDayShortLayout, string(data))
+		if shortErr != nil {
+			return err
+		}
+		parsed = short
+	}
+	*t = parsed
+
+	return nil
+}
+
+// Scan implements the sql.Scanner interface. It accepts time.Time that the time of day is in the
+// location of the time, and the strings and the bytes in the form of "HH:mm:ss" or "HH:mm". A nil
+// value is midnight.
+func (t *TimeOfDay) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*t = TimeOfDay{}
+	case time.Time:
+		*t = TimeOfDayOf(New(v))
+	case Time:
+		*t = TimeOfDayOf(v)
+	case string:
+		return t.UnmarshalText([]byte(v))
+	case []byte:
+		return t.UnmarshalText(v)
+	default:
+		return ErrUnsupportedType
+	}
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface, the value is a string in the form of "HH:mm:ss".
+func (t TimeOfDay) Value() (driver.Value, error) {
+	return t.String(), nil
+}
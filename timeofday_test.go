@@ -0,0 +1,186 @@
+package date_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ghosind/go-assert"
+	"github.com/ghosind/go-date"
+)
+
+func TestTimeOfDay(t *testing.T) {
+	a := assert.New(t)
+
+	tod := date.NewTimeOfDay(9, 30, 0, 0)
+	a.EqualNow(tod, date.TimeOfDay{Hour: 9, Minute: 30})
+	a.EqualNow(date.NewTimeOfDay(25, 0, 0, 0), date.NewTimeOfDay(1, 0, 0, 0))
+	a.EqualNow(date.NewTimeOfDay(0, -30, 0, 0), date.NewTimeOfDay(23, 30, 0, 0))
+	a.TrueNow(tod.IsValid())
+	a.NotTrueNow(date.TimeOfDay{Hour: 24}.IsValid())
+	a.EqualNow(tod.SinceMidnight(), 9*time.Hour+30*time.Minute)
+	a.EqualNow(tod.String(), "09:30:00")
+	a.EqualNow(date.NewTimeOfDay(9, 30, 0, 500000000).String(), "09:30:00.5")
+
+	tm := date.Date(2024, time.January, 10, 9, 30, 0, 0, time.UTC)
+	a.EqualNow(date.TimeOfDayOf(tm), tod)
+
+	a.EqualNow(tod.Add(time.Hour), date.NewTimeOfDay(10, 30, 0, 0))
+	a.EqualNow(date.NewTimeOfDay(23, 0, 0, 0).Add(2*time.Hour), date.NewTimeOfDay(1, 0, 0, 0))
+	a.EqualNow(tod.Add(-10*time.Hour), date.NewTimeOfDay(23, 30, 0, 0))
+
+	a.EqualNow(tod.Compare(tod), 0)
+	a.EqualNow(tod.Compare(date.NewTimeOfDay(10, 0, 0, 0)), -1)
+	a.EqualNow(tod.Compare(date.NewTimeOfDay(9, 0, 0, 0)), 1)
+	a.TrueNow(tod.Before(tod.Add(time.Nanosecond)))
+	a.NotTrueNow(tod.Before(tod))
+	a.TrueNow(tod.After(tod.Add(-time.Second)))
+	a.NotTrueNow(tod.After(tod))
+}
+
+func TestTimeOfDayOn(t *testing.T) {
+	a := assert.New(t)
+
+	tod := date.NewTimeOfDay(9, 30, 0, 0)
+	loc := time.FixedZone("UTC+8", 8*60*60)
+
+	tm := tod.On(date.NewCivilDate(2024, time.January, 10), loc)
+	a.TrueNow(tm.Equal(date.Date(2024, time.January, 10, 9, 30, 0, 0, loc)))
+	a.EqualNow(tm.Location(), loc)
+
+	// the date of the time in its location
+	src := time.Date(2024, time.January, 10, 23, 0, 0, 0, loc)
+	a.TrueNow(tod.On(src, time.UTC).Equal(date.Date(2024, time.January, 10, 9, 30, 0, 0, time.UTC)))
+	a.TrueNow(tod.On(date.New(src), time.UTC).Equal(
+		date.Date(2024, time.January, 10, 9, 30, 0, 0, time.UTC)))
+
+	a.PanicNow(func() {
+		tod.On("2024-01-10", time.UTC)
+	})
+}
+
+func TestTimeOfDayOnDST(t *testing.T) {
+	a := assert.New(t)
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone database is not available")
+	}
+	edt := time.FixedZone("EDT", -4*60*60)
+	est := time.FixedZone("EST", -5*60*60)
+
+	// the clocks skip from 02:00 to 03:00, the time is moved forward by the gap
+	tm := date.NewTimeOfDay(2, 30, 0, 0).On(date.NewCivilDate(2024, time.March, 10), loc)
+	a.TrueNow(tm.Equal(date.Date(2024, time.March, 10, 3, 30, 0, 0, edt)))
+	a.EqualNow(date.TimeOfDayOf(tm), date.NewTimeOfDay(3, 30, 0, 0))
+
+	tm = date.NewTimeOfDay(3, 0, 0, 0).On(date.NewCivilDate(2024, time.March, 10), loc)
+	a.TrueNow(tm.Equal(date.Date(2024, time.March, 10, 3, 0, 0, 0, edt)))
+
+	// the clocks go back from 02:00 to 01:00, the time is the earlier one
+	tm = date.NewTimeOfDay(1, 30, 0, 0).On(date.NewCivilDate(2024, time.November, 3), loc)
+	a.TrueNow(tm.Equal(date.Date(2024, time.November, 3, 1, 30, 0, 0, edt)))
+
+	tm = date.NewTimeOfDay(2, 30, 0, 0).On(date.NewCivilDate(2024, time.November, 3), loc)
+	a.TrueNow(tm.Equal(date.Date(2024, time.November, 3, 2, 30, 0, 0, est)))
+}
+
+func TestTimeOfDayFormat(t *testing.T) {
+	a := assert.New(t)
+
+	tod := date.NewTimeOfDay(21, 5, 9, 123000000)
+	a.EqualNow(tod.Format("HH:mm"), "21:05")
+	a.EqualNow(tod.Format("h:mm A"), "9:05 PM")
+	a.EqualNow(tod.Format("hh:mm:ss.SSS a"), "09:05:09.123 pm")
+	// the date tokens are the literal text
+	a.EqualNow(tod.Format("YYYY-MM-DD HH:mm"), "YYYY-MM-DD 21:05")
+
+	parsed, err := date.ParseTimeOfDay("HH:mm", "21:05")
+	a.NilNow(err)
+	a.EqualNow(parsed, date.NewTimeOfDay(21, 5, 0, 0))
+
+	parsed, err = date.ParseTimeOfDay("h:mm:ss.SSS A", "9:05:09.123 PM")
+	a.NilNow(err)
+	a.EqualNow(parsed, tod)
+
+	_, err = date.ParseTimeOfDay("HH:mm", "25:00")
+	a.NotNilNow(err)
+	a.TrueNow(errors.Is(err, date.ErrRange))
+
+	_, err = date.ParseTimeOfDay("YYYY HH:mm", "2024 09:30")
+	a.NotNilNow(err)
+	a.TrueNow(errors.Is(err, date.ErrUnexpectedText))
+}
+
+func TestTimeOfDayJSON(t *testing.T) {
+	a := assert.New(t)
+
+	type shift struct {
+		Start date.TimeOfDay `json:"start"`
+	}
+
+	data, err := json.Marshal(shift{Start: date.NewTimeOfDay(9, 30, 0, 0)})
+	a.NilNow(err)
+	a.EqualNow(string(data), `{"start":"09:30:00"}`)
+
+	var s shift
+	a.NilNow(json.Unmarshal([]byte(`{"start":"09:30:00"}`), &s))
+	a.EqualNow(s.Start, date.NewTimeOfDay(9, 30, 0, 0))
+
+	a.NilNow(json.Unmarshal([]byte(`{"start":null}`), &s))
+	a.EqualNow(s.Start, date.NewTimeOfDay(9, 30, 0, 0))
+
+	a.NilNow(json.Unmarshal([]byte(`{"start":"17:45:30.25"}`), &s))
+	a.EqualNow(s.Start, date.NewTimeOfDay(17, 45, 30, 250000000))
+
+	a.NilNow(json.Unmarshal([]byte(`{"start":""}`), &s))
+	a.EqualNow(s.Start, date.TimeOfDay{})
+
+	// the seconds are optional
+	a.NilNow(json.Unmarshal([]byte(`{"start":"18:05"}`), &s))
+	a.EqualNow(s.Start, date.NewTimeOfDay(18, 5, 0, 0))
+
+	a.NotNilNow(json.Unmarshal([]byte(`{"start":"9.30"}`), &s))
+	err = json.Unmarshal([]byte(`{"start":"25:00"}`), &s)
+	a.TrueNow(errors.Is(err, date.ErrRange))
+
+	text, err := date.NewTimeOfDay(9, 30, 0, 0).MarshalText()
+	a.NilNow(err)
+	a.EqualNow(string(text), "09:30:00")
+}
+
+func TestTimeOfDaySQL(t *testing.T) {
+	a := assert.New(t)
+	loc := time.FixedZone("UTC+8", 8*60*60)
+
+	db, err := sql.Open("date-echo", "")
+	a.NilNow(err)
+	defer db.Close()
+
+	cases := []struct {
+		value  any
+		expect date.TimeOfDay
+	}{
+		{time.Date(2024, 1, 10, 9, 30, 0, 0, loc), date.NewTimeOfDay(9, 30, 0, 0)},
+		{"09:30:00", date.NewTimeOfDay(9, 30, 0, 0)},
+		{[]byte("09:30:00.5"), date.NewTimeOfDay(9, 30, 0, 500000000)},
+		{"09:30", date.NewTimeOfDay(9, 30, 0, 0)},
+		{nil, date.TimeOfDay{}},
+	}
+
+	for _, c := range cases {
+		var tod date.TimeOfDay
+		a.NilNow(db.QueryRow("SELECT ?", c.value).Scan(&tod), c.value)
+		a.EqualNow(tod, c.expect, c.value)
+	}
+
+	var tod date.TimeOfDay
+	err = db.QueryRow("SELECT ?", int64(1)).Scan(&tod)
+	a.TrueNow(errors.Is(err, date.ErrUnsupportedType))
+
+	_, err = db.Exec("INSERT", date.NewTimeOfDay(9, 30, 0, 0))
+	a.NilNow(err)
+	a.EqualNow(echo.args[0], "09:30:00")
+}
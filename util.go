@@ -17,6 +17,81 @@ func getTime(t any) time.Time {
 	}
 }
 
+// daysIn returns the number of days in the month of the year.
+func daysIn(month time.Month, year int) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// daysInYear returns the number of days in the year, it's 366 in leap years and 365 in the other
+// years.
+func daysInYear(year int) int {
+	return time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+}
+
+// addMonths adds the number of the months to the date, and clamps the day to the last day of the
+// month, for example one month after January 31 is the last day of February.
+func addMonths(year int, month time.Month, day, months int) (int, time.Month, int) {
+	y, m, _ := time.Date(year, month+time.Month(months), 1, 0, 0, 0, 0, time.UTC).Date()
+	if days := daysIn(m, y); day > days {
+		day = days
+	}
+	return y, m, day
+}
+
+// twoDigitYear converts the two-digits year to the year in the range [1969, 2068].
+func twoDigitYear(year int) int {
+	if year >= 69 {
+		return year + 1900
+	}
+	return year + 2000
+}
+
+// isoWeekStart returns the date of the Monday of the ISO 8601 week of the ISO week-based year.
+func isoWeekStart(year, week int) (int, time.Month, int) {
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	offset := (int(jan4.Weekday()) + 6) % 7
+	return time.Date(year, time.January, 4-offset+(week-1)*7, 0, 0, 0, 0, time.UTC).Date()
+}
+
+// startOfISOWeekYear returns the start time of the ISO 8601 week-based year in the location.
+func startOfISOWeekYear(year int, loc *time.Location) Time {
+	y, m, d := isoWeekStart(year, 1)
+	return Date(y, m, d, 0, 0, 0, 0, loc)
+}
+
+// isoWeeksIn returns the number of the ISO 8601 weeks in the ISO week-based year, 52 or 53.
+func isoWeeksIn(year int) int {
+	_, week := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	return week
+}
+
+// The paddings of the numeric tokens.
+const (
+	// padNone appends the number without padding.
+	padNone byte = '-'
+	// padSpace pads the number with spaces.
+	padSpace byte = '_'
+	// padZero pads the number with zeros.
+	padZero byte = '0'
+)
+
+// appendPadded appends the number with the padding to the width.
+func appendPadded(buf []byte, val, width int, pad byte) []byte {
+	switch pad {
+	case padNone:
+		return appendIntToBuffer(buf, val, 1)
+	case padSpace:
+		for n, i := val, 1; i < width; i++ {
+			if n /= 10; n == 0 {
+				buf = append(buf, ' ')
+			}
+		}
+		return appendIntToBuffer(buf, val, 1)
+	default:
+		return appendIntToBuffer(buf, val, width)
+	}
+}
+
 // appendIntToBuffer converts the integer value to a textual representation string, and padding
 // with '0' if the length is less than the minimum width requirement.
 func appendIntToBuffer(buf []byte, val int, width int) []byte {
@@ -53,6 +128,43 @@ func appendIntToBuffer(buf []byte, val int, width int) []byte {
 	return buf
 }
 
+// appendFraction appends the nanoseconds as the fractional second without the trailing zeros, and
+// it keeps at least one digit.
+func appendFraction(buf []byte, nsec int) []byte {
+	digits := 9
+	for digits > 1 && nsec%10 == 0 {
+		nsec /= 10
+		digits--
+	}
+
+	return appendIntToBuffer(buf, nsec, digits)
+}
+
+// appendNano appends the separator and the first digits of the nanoseconds as the fractional
+// second. If trim is true, the trailing zeros are removed, and nothing is appended if all the
+// digits are zeros.
+func appendNano(buf []byte, nsec int, sep byte, digits int, trim bool) []byte {
+	if digits > 9 {
+		digits = 9
+	}
+	for i := digits; i < 9; i++ {
+		nsec /= 10
+	}
+
+	if trim {
+		for digits > 0 && nsec%10 == 0 {
+			nsec /= 10
+			digits--
+		}
+		if digits == 0 {
+			return buf
+		}
+	}
+
+	buf = append(buf, sep)
+	return appendIntToBuffer(buf, nsec, digits)
+}
+
 // lookup tries to find the index in the list that the element is the prefix of the provided
 // string, and it is case-insensitive.
 func lookup(list []string, value string) (int, string, error) {
@@ -99,3 +211,178 @@ func readNum(value string, width int, fixed bool) (int, string, error) {
 
 	return num, value, nil
 }
+
+// readBracketLiteral reads the literal text in the brackets at the beginning of the layout, for
+// example "at" of "[at] HH:mm". The backslash escapes the next character in the brackets, so
+// "[a\\]b]" is the text "a]b". It returns false if the brackets are not closed.
+func readBracketLiteral(layout string) (string, string, bool) {
+	var buf []byte
+	escaped := false
+	start := 1
+
+	for i := 1; i < len(layout); i++ {
+		switch layout[i] {
+		case '\\':
+			if i+1 < len(layout) {
+				buf = append(buf, layout[start:i]...)
+				escaped = true
+				start = i + 1
+				i++
+			}
+		case ']':
+			if !escaped {
+				return layout[1:i], layout[i+1:], true
+			}
+			buf = append(buf, layout[start:i]...)
+			return string(buf), layout[i+1:], true
+		}
+	}
+
+	return "", layout, false
+}
+
+// appendOrdinal appends the number and its ordinal suffix in the locale, for example "1st".
+func appendOrdinal(buf []byte, num int, locale *Locale) []byte {
+	buf = appendIntToBuffer(buf, num, 1)
+	return append(buf, locale.ordinal(num)...)
+}
+
+// readOrdinal reads a number that has at most width digits and the ordinal suffix of the number in
+// the locale, for example "22nd".
+func readOrdinal(value string, width int, locale *Locale) (int, string, error) {
+	num, suffix, err := readNum(value, width, false)
+	if err != nil {
+		return -1, value, err
+	}
+
+	ord := locale.ordinal(num)
+	if len(suffix) < len(ord) || !strings.EqualFold(suffix[0:len(ord)], ord) {
+		return -1, value, errParse
+	}
+
+	return num, suffix[len(ord):], nil
+}
+
+// readUnix reads the signed seconds since the Unix epoch.
+func readUnix(value string) (int64, string, error) {
+	i := 0
+	if len(value) > 0 && (value[0] == '-' || value[0] == '+') {
+		i++
+	}
+	for ; i < len(value) && value[i] >= '0' && value[i] <= '9'; i++ {
+	}
+
+	sec, err := strconv.ParseInt(value[0:i], 10, 64)
+	if err != nil {
+		return 0, value, errParse
+	}
+
+	return sec, value[i:], nil
+}
+
+// weekOfYear returns the week of year of the time, the first day of week 1 is the first weekday
+// of the year, and the days before it are in week 0.
+func weekOfYear(t Time, first time.Weekday) int {
+	return (t.YearDay() + 6 - daysSinceWeekday(t.Weekday(), first)) / 7
+}
+
+// readFraction reads the digits of the fractional second like readNum, and converts the digits to
+// nanoseconds.
+func readFraction(value string, width int, fixed bool) (int, string, error) {
+	num, suffix, err := readNum(value, width, fixed)
+	if err != nil {
+		return -1, value, err
+	}
+
+	for digits := len(value) - len(suffix); digits < 9; digits++ {
+		num *= 10
+	}
+
+	return num, suffix, nil
+}
+
+// compareInt returns -1 if a is less than b, 1 if a is greater than b, or 0 if they're equal.
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// abs returns the absolute value of the integer.
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// readOffset reads the timezone offset in the form of the token, like "-0700" for layoutTokenTZ,
+// "-07:00" for layoutTokenTZColon, or "-07" for layoutTokenTZHour, and returns the hours and the
+// minutes of the offset, both of them are negative for the west of UTC.
+func readOffset(value string, token int) (int, int, string, error) {
+	width := 5
+	switch token {
+	case layoutTokenTZColon, layoutTokenTZISOColon:
+		width = 6
+	case layoutTokenTZHour, layoutTokenTZISOHour:
+		width = 3
+	}
+
+	if len(value) < width || (value[0] != '+' && value[0] != '-') {
+		return 0, 0, value, errParse
+	}
+
+	hour, _, err := readNum(value[1:3], 2, true)
+	if err != nil {
+		return 0, 0, value, errParse
+	}
+
+	min := 0
+	switch width {
+	case 5:
+		min, _, err = readNum(value[3:5], 2, true)
+	case 6:
+		if value[3] != ':' {
+			return 0, 0, value, errParse
+		}
+		min, _, err = readNum(value[4:6], 2, true)
+	}
+	if err != nil {
+		return 0, 0, value, errParse
+	}
+
+	if value[0] == '-' {
+		hour, min = -hour, -min
+	}
+
+	return hour, min, value[width:], nil
+}
+
+// hasLeadingFraction reports whether the value begins with a period or comma separator that is
+// followed by a digit.
+func hasLeadingFraction(value string) bool {
+	return len(value) >= 2 && (value[0] == '.' || value[0] == ',') &&
+		value[1] >= '0' && value[1] <= '9'
+}
+
+// readLeadingFraction reads the separator and all the following digits as the fractional second,
+// and returns the nanoseconds. The digits after the ninth are ignored.
+func readLeadingFraction(value string) (int, string) {
+	n := 1
+	for n < len(value) && value[n] >= '0' && value[n] <= '9' {
+		n++
+	}
+
+	digits := value[1:n]
+	if len(digits) > 9 {
+		digits = digits[0:9]
+	}
+	nsec, _, _ := readFraction(digits, 9, false)
+
+	return nsec, value[n:]
+}
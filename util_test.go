@@ -9,7 +9,7 @@ import (
 
 func TestNextLayoutToken(t *testing.T) {
 	a := assert.New(t)
-	layout := "YYYY YY MMMM MMM MM M DD D dddd ddd d HH H hh h mm m ss s SSS SS S A a Z ZZ \\Ho"
+	layout := "YYYY YY MMMM MMM MM M DD D dddd ddd d HH H hh h mm m ss s SSS SS S SSSSSS SSSSSSSSS F A a Z ZZ z zz W WW GGGG GG E DDDD DDD Q Do Mo Qo do X x [at] \\Ho"
 	expectedTokens := []int{
 		layoutTokenYearLong, layoutTokenNone,
 		layoutTokenYear, layoutTokenNone,
@@ -33,10 +33,30 @@ func TestNextLayoutToken(t *testing.T) {
 		layoutTokenMillisecond, layoutTokenNone,
 		layoutTokenMillisecondTen, layoutTokenNone,
 		layoutTokenMillisecondHundred, layoutTokenNone,
+		layoutTokenMicrosecond, layoutTokenNone,
+		layoutTokenNanosecond, layoutTokenNone,
+		layoutTokenFraction, layoutTokenNone,
 		layoutTokenPMUpper, layoutTokenNone,
 		layoutTokenPMLower, layoutTokenNone,
 		layoutTokenTZColon, layoutTokenNone,
 		layoutTokenTZ, layoutTokenNone,
+		layoutTokenTZAbbr, layoutTokenNone,
+		layoutTokenTZName, layoutTokenNone,
+		layoutTokenISOWeek, layoutTokenNone,
+		layoutTokenISOWeekLong, layoutTokenNone,
+		layoutTokenISOWeekYearLong, layoutTokenNone,
+		layoutTokenISOWeekYear, layoutTokenNone,
+		layoutTokenISOWeekday, layoutTokenNone,
+		layoutTokenDayOfYearLong, layoutTokenNone,
+		layoutTokenDayOfYear, layoutTokenNone,
+		layoutTokenQuarter, layoutTokenNone,
+		layoutTokenDayOrdinal, layoutTokenNone,
+		layoutTokenMonthOrdinal, layoutTokenNone,
+		layoutTokenQuarterOrdinal, layoutTokenNone,
+		layoutTokenDayOfWeekOrdinal, layoutTokenNone,
+		layoutTokenUnix, layoutTokenNone,
+		layoutTokenUnixMilli, layoutTokenNone,
+		layoutTokenNone, layoutTokenNone,
 		layoutTokenNone, layoutTokenNone,
 		layoutTokenEnd,
 	}
@@ -52,7 +72,8 @@ func TestNextLayoutToken(t *testing.T) {
 
 func TestNextLayoutTokenWithBuiltinLayout(t *testing.T) {
 	a := assert.New(t)
-	layout := "2006 06 January Jan 01 1 02 2 Monday Mon 15 03 3 04 4 05 5 PM 0"
+	layout := "2006 06 January Jan 01 1 02 2 _2 __2 002 Monday Mon 15 03 3 04 4 05 5 .000 ,999 " +
+		"PM pm MST -0700 -07:00 -07 Z0700 Z07:00 Z07 0"
 	expectedTokens := []int{
 		layoutTokenYearLong, layoutTokenNone,
 		layoutTokenYear, layoutTokenNone,
@@ -62,6 +83,9 @@ func TestNextLayoutTokenWithBuiltinLayout(t *testing.T) {
 		layoutTokenMonth, layoutTokenNone,
 		layoutTokenDayLong, layoutTokenNone,
 		layoutTokenDay, layoutTokenNone,
+		layoutTokenDaySpace, layoutTokenNone,
+		layoutTokenDayOfYearSpace, layoutTokenNone,
+		layoutTokenDayOfYearLong, layoutTokenNone,
 		layoutTokenDayOfWeekFull, layoutTokenNone,
 		layoutTokenDayOfWeekAbbr, layoutTokenNone,
 		layoutTokenHourLong, layoutTokenNone,
@@ -71,7 +95,17 @@ func TestNextLayoutTokenWithBuiltinLayout(t *testing.T) {
 		layoutTokenMinute, layoutTokenNone,
 		layoutTokenSecondLong, layoutTokenNone,
 		layoutTokenSecond, layoutTokenNone,
+		layoutTokenFractionFixed, layoutTokenNone,
+		layoutTokenFractionTrim, layoutTokenNone,
 		layoutTokenPMUpper, layoutTokenNone,
+		layoutTokenPMLower, layoutTokenNone,
+		layoutTokenTZAbbr, layoutTokenNone,
+		layoutTokenTZ, layoutTokenNone,
+		layoutTokenTZColon, layoutTokenNone,
+		layoutTokenTZHour, layoutTokenNone,
+		layoutTokenTZISO, layoutTokenNone,
+		layoutTokenTZISOColon, layoutTokenNone,
+		layoutTokenTZISOHour, layoutTokenNone,
 		layoutTokenNone,
 		layoutTokenEnd,
 	}
@@ -170,3 +204,235 @@ func testReadNum(
 		a.EqualNow(i, expected)
 	}
 }
+
+func TestDaysIn(t *testing.T) {
+	a := assert.New(t)
+
+	a.EqualNow(daysIn(time.January, 2024), 31)
+	a.EqualNow(daysIn(time.February, 2024), 29)
+	a.EqualNow(daysIn(time.February, 2023), 28)
+	a.EqualNow(daysIn(time.February, 1900), 28)
+	a.EqualNow(daysIn(time.February, 2000), 29)
+	a.EqualNow(daysIn(time.April, 2024), 30)
+}
+
+func TestDaysInYear(t *testing.T) {
+	a := assert.New(t)
+
+	a.EqualNow(daysInYear(2024), 366)
+	a.EqualNow(daysInYear(2023), 365)
+	a.EqualNow(daysInYear(1900), 365)
+	a.EqualNow(daysInYear(2000), 366)
+}
+
+func TestISOWeekStart(t *testing.T) {
+	a := assert.New(t)
+
+	year, month, day := isoWeekStart(2024, 1)
+	a.EqualNow(year, 2024)
+	a.EqualNow(month, time.January)
+	a.EqualNow(day, 1)
+
+	year, month, day = isoWeekStart(2021, 1)
+	a.EqualNow(year, 2021)
+	a.EqualNow(month, time.January)
+	a.EqualNow(day, 4)
+
+	year, month, day = isoWeekStart(2020, 53)
+	a.EqualNow(year, 2020)
+	a.EqualNow(month, time.December)
+	a.EqualNow(day, 28)
+}
+
+func TestISOWeeksIn(t *testing.T) {
+	a := assert.New(t)
+
+	a.EqualNow(isoWeeksIn(2020), 53)
+	a.EqualNow(isoWeeksIn(2021), 52)
+	a.EqualNow(isoWeeksIn(2024), 52)
+	a.EqualNow(isoWeeksIn(2026), 53)
+}
+
+func TestReadBracketLiteral(t *testing.T) {
+	a := assert.New(t)
+
+	text, suffix, ok := readBracketLiteral("[at] HH:mm")
+	a.TrueNow(ok)
+	a.EqualNow(text, "at")
+	a.EqualNow(suffix, " HH:mm")
+
+	text, suffix, ok = readBracketLiteral("[]x")
+	a.TrueNow(ok)
+	a.EqualNow(text, "")
+	a.EqualNow(suffix, "x")
+
+	text, suffix, ok = readBracketLiteral("[a\\]b\\\\c]d")
+	a.TrueNow(ok)
+	a.EqualNow(text, "a]b\\c")
+	a.EqualNow(suffix, "d")
+
+	_, suffix, ok = readBracketLiteral("[at HH:mm")
+	a.NotTrueNow(ok)
+	a.EqualNow(suffix, "[at HH:mm")
+
+	_, _, ok = readBracketLiteral("[at\\]")
+	a.NotTrueNow(ok)
+}
+
+func TestAppendPadded(t *testing.T) {
+	a := assert.New(t)
+
+	a.EqualNow(string(appendPadded(nil, 5, 2, padZero)), "05")
+	a.EqualNow(string(appendPadded(nil, 5, 2, padSpace)), " 5")
+	a.EqualNow(string(appendPadded(nil, 5, 3, padSpace)), "  5")
+	a.EqualNow(string(appendPadded(nil, 45, 3, padSpace)), " 45")
+	a.EqualNow(string(appendPadded(nil, 12, 2, padSpace)), "12")
+	a.EqualNow(string(appendPadded(nil, 5, 2, padNone)), "5")
+	a.EqualNow(string(appendPadded(nil, 0, 2, padNone)), "0")
+}
+
+func TestReadUnix(t *testing.T) {
+	a := assert.New(t)
+
+	sec, value, err := readUnix("1704395045 x")
+	a.NilNow(err)
+	a.EqualNow(sec, int64(1704395045))
+	a.EqualNow(value, " x")
+
+	sec, value, err = readUnix("-86400")
+	a.NilNow(err)
+	a.EqualNow(sec, int64(-86400))
+	a.EqualNow(value, "")
+
+	_, value, err = readUnix("-x")
+	a.NotNilNow(err)
+	a.EqualNow(value, "-x")
+}
+
+func TestWeekOfYear(t *testing.T) {
+	a := assert.New(t)
+
+	// 2024-01-01 is Monday
+	a.EqualNow(weekOfYear(Date(2024, 1, 1, 0, 0, 0, 0), time.Sunday), 0)
+	a.EqualNow(weekOfYear(Date(2024, 1, 1, 0, 0, 0, 0), time.Monday), 1)
+	a.EqualNow(weekOfYear(Date(2024, 1, 7, 0, 0, 0, 0), time.Sunday), 1)
+	a.EqualNow(weekOfYear(Date(2024, 1, 7, 0, 0, 0, 0), time.Monday), 1)
+	a.EqualNow(weekOfYear(Date(2024, 12, 31, 0, 0, 0, 0), time.Sunday), 52)
+	a.EqualNow(weekOfYear(Date(2024, 12, 31, 0, 0, 0, 0), time.Monday), 53)
+}
+
+func TestAppendOrdinal(t *testing.T) {
+	a := assert.New(t)
+
+	a.EqualNow(string(appendOrdinal(nil, 1, English)), "1st")
+	a.EqualNow(string(appendOrdinal(nil, 12, English)), "12th")
+	a.EqualNow(string(appendOrdinal(nil, 103, English)), "103rd")
+	a.EqualNow(string(appendOrdinal(nil, 2, &Locale{})), "2nd")
+}
+
+func TestReadOrdinal(t *testing.T) {
+	a := assert.New(t)
+
+	num, value, err := readOrdinal("22nd of", 2, English)
+	a.NilNow(err)
+	a.EqualNow(num, 22)
+	a.EqualNow(value, " of")
+
+	num, value, err = readOrdinal("3RD", 2, English)
+	a.NilNow(err)
+	a.EqualNow(num, 3)
+	a.EqualNow(value, "")
+
+	_, value, err = readOrdinal("22th", 2, English)
+	a.NotNilNow(err)
+	a.EqualNow(value, "22th")
+
+	_, _, err = readOrdinal("st", 2, English)
+	a.NotNilNow(err)
+}
+
+func TestAppendFraction(t *testing.T) {
+	a := assert.New(t)
+
+	a.EqualNow(string(appendFraction(nil, 0)), "0")
+	a.EqualNow(string(appendFraction(nil, 100000000)), "1")
+	a.EqualNow(string(appendFraction(nil, 123450000)), "12345")
+	a.EqualNow(string(appendFraction(nil, 1000)), "000001")
+	a.EqualNow(string(appendFraction(nil, 123456789)), "123456789")
+}
+
+func TestReadFraction(t *testing.T) {
+	a := assert.New(t)
+
+	nsec, s, err := readFraction("5", 1, true)
+	a.NilNow(err)
+	a.EqualNow(nsec, 500000000)
+	a.EqualNow(s, "")
+
+	nsec, s, err = readFraction("123456 ", 6, true)
+	a.NilNow(err)
+	a.EqualNow(nsec, 123456000)
+	a.EqualNow(s, " ")
+
+	nsec, s, err = readFraction("0012Z", 9, false)
+	a.NilNow(err)
+	a.EqualNow(nsec, 1200000)
+	a.EqualNow(s, "Z")
+
+	_, s, err = readFraction("12", 3, true)
+	a.NotNilNow(err)
+	a.EqualNow(s, "12")
+}
+
+func TestAppendNano(t *testing.T) {
+	a := assert.New(t)
+
+	a.EqualNow(string(appendNano(nil, 123456789, '.', 3, false)), ".123")
+	a.EqualNow(string(appendNano(nil, 0, ',', 3, false)), ",000")
+	a.EqualNow(string(appendNano(nil, 120000000, '.', 9, true)), ".12")
+	a.EqualNow(string(appendNano(nil, 1000, '.', 3, true)), "")
+	a.EqualNow(string(appendNano(nil, 0, '.', 9, true)), "")
+}
+
+func TestReadOffset(t *testing.T) {
+	a := assert.New(t)
+
+	hour, min, s, err := readOffset("+0800 X", layoutTokenTZ)
+	a.NilNow(err)
+	a.EqualNow(hour, 8)
+	a.EqualNow(min, 0)
+	a.EqualNow(s, " X")
+
+	hour, min, _, err = readOffset("-03:30", layoutTokenTZColon)
+	a.NilNow(err)
+	a.EqualNow(hour, -3)
+	a.EqualNow(min, -30)
+
+	hour, _, _, err = readOffset("-07", layoutTokenTZHour)
+	a.NilNow(err)
+	a.EqualNow(hour, -7)
+
+	_, _, s, err = readOffset("+0800", layoutTokenTZColon)
+	a.NotNilNow(err)
+	a.EqualNow(s, "+0800")
+
+	_, _, _, err = readOffset("08:00", layoutTokenTZColon)
+	a.NotNilNow(err)
+}
+
+func TestReadLeadingFraction(t *testing.T) {
+	a := assert.New(t)
+
+	a.TrueNow(hasLeadingFraction(".1"))
+	a.TrueNow(hasLeadingFraction(",1"))
+	a.NotTrueNow(hasLeadingFraction("."))
+	a.NotTrueNow(hasLeadingFraction(".Z"))
+
+	nsec, s := readLeadingFraction(".123Z")
+	a.EqualNow(nsec, 123000000)
+	a.EqualNow(s, "Z")
+
+	nsec, s = readLeadingFraction(",1234567891")
+	a.EqualNow(nsec, 123456789)
+	a.EqualNow(s, "")
+}
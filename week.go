@@ -0,0 +1,34 @@
+package date
+
+import (
+	"sync"
+	"time"
+)
+
+var (
+	firstDayOfWeekMutex sync.RWMutex
+	firstDayOfWeek      = time.Monday
+)
+
+// SetFirstDayOfWeek sets the default first day of week, it's used by StartOfWeek and EndOfWeek.
+// The default value is Monday, the first day of the ISO 8601 week.
+func SetFirstDayOfWeek(weekday time.Weekday) {
+	firstDayOfWeekMutex.Lock()
+	defer firstDayOfWeekMutex.Unlock()
+
+	firstDayOfWeek = weekday
+}
+
+// FirstDayOfWeek returns the default first day of week.
+func FirstDayOfWeek() time.Weekday {
+	firstDayOfWeekMutex.RLock()
+	defer firstDayOfWeekMutex.RUnlock()
+
+	return firstDayOfWeek
+}
+
+// daysSinceWeekday returns the number of the days from the latest first weekday to the weekday, in
+// the range [0, 6].
+func daysSinceWeekday(weekday, first time.Weekday) int {
+	return ((int(weekday)-int(first))%7 + 7) % 7
+}
@@ -0,0 +1,23 @@
+package date_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ghosind/go-assert"
+	"github.com/ghosind/go-date"
+)
+
+func TestSetFirstDayOfWeek(t *testing.T) {
+	a := assert.New(t)
+
+	a.EqualNow(date.FirstDayOfWeek(), time.Monday)
+
+	date.SetFirstDayOfWeek(time.Sunday)
+	defer date.SetFirstDayOfWeek(time.Monday)
+	a.EqualNow(date.FirstDayOfWeek(), time.Sunday)
+
+	tm := date.Date(2006, 2, 2, 15, 4, 5, 0)
+	a.TrueNow(tm.StartOfWeek().Equal(date.Date(2006, 1, 29, 0, 0, 0, 0)))
+	a.TrueNow(tm.EndOfWeek().Equal(date.Date(2006, 2, 4, 23, 59, 59, 999999999)))
+}
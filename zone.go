@@ -0,0 +1,284 @@
+package date
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	zoneAbbrsMutex sync.RWMutex
+	// zoneAbbrs is the table of the timezone abbreviations and their offsets (in seconds east of
+	// UTC), it's used to resolve the abbreviations that do not match the location of parsing.
+	zoneAbbrs = map[string]int{
+		"UTC":  0,
+		"GMT":  0,
+		"EST":  -5 * 3600,
+		"EDT":  -4 * 3600,
+		"CST":  -6 * 3600,
+		"CDT":  -5 * 3600,
+		"MST":  -7 * 3600,
+		"MDT":  -6 * 3600,
+		"PST":  -8 * 3600,
+		"PDT":  -7 * 3600,
+		"AKST": -9 * 3600,
+		"AKDT": -8 * 3600,
+		"HST":  -10 * 3600,
+		"WET":  0,
+		"WEST": 1 * 3600,
+		"BST":  1 * 3600,
+		"CET":  1 * 3600,
+		"CEST": 2 * 3600,
+		"EET":  2 * 3600,
+		"EEST": 3 * 3600,
+		"MSK":  3 * 3600,
+		"IST":  5*3600 + 30*60,
+		"HKT":  8 * 3600,
+		"AWST": 8 * 3600,
+		"JST":  9 * 3600,
+		"KST":  9 * 3600,
+		"ACST": 9*3600 + 30*60,
+		"ACDT": 10*3600 + 30*60,
+		"AEST": 10 * 3600,
+		"AEDT": 11 * 3600,
+		"NZST": 12 * 3600,
+		"NZDT": 13 * 3600,
+	}
+)
+
+// RegisterZoneAbbreviation adds the timezone abbreviation with the offset (in seconds east of
+// UTC) into the abbreviation table, and it'll overwrite the offset of the existing abbreviation.
+//
+// The table is used to resolve the abbreviations of the "z" token when parsing, if the
+// abbreviation is not the name of the zone of the location at that time. The abbreviations are
+// ambiguous, for example "CST" is the Central Standard Time (-06:00) in the table by default, but
+// it's also the China Standard Time (+08:00) if the location is "Asia/Shanghai".
+func RegisterZoneAbbreviation(abbr string, offset int) {
+	zoneAbbrsMutex.Lock()
+	defer zoneAbbrsMutex.Unlock()
+
+	zoneAbbrs[abbr] = offset
+}
+
+// lookupZoneAbbreviation returns the offset (in seconds east of UTC) of the timezone abbreviation
+// in the abbreviation table.
+func lookupZoneAbbreviation(abbr string) (int, bool) {
+	zoneAbbrsMutex.RLock()
+	defer zoneAbbrsMutex.RUnlock()
+
+	offset, ok := zoneAbbrs[abbr]
+	return offset, ok
+}
+
+// readZoneAbbr reads a timezone abbreviation from the value, it's 3 to 5 upper-case letters like
+// "CST", or a sign and the hours like "+08" or "-0330" that are used as the abbreviation by the
+// zones without a commonly used abbreviation.
+func readZoneAbbr(value string) (string, string, error) {
+	if len(value) > 0 && (value[0] == '+' || value[0] == '-') {
+		i := 1
+		for ; i < len(value) && i <= 4 && value[i] >= '0' && value[i] <= '9'; i++ {
+		}
+		if i != 3 && i != 5 {
+			return "", value, errParse
+		}
+		return value[0:i], value[i:], nil
+	}
+
+	i := 0
+	for ; i < len(value) && i < 5 && value[i] >= 'A' && value[i] <= 'Z'; i++ {
+	}
+	if i < 3 {
+		return "", value, errParse
+	}
+
+	return value[0:i], value[i:], nil
+}
+
+// readReferenceZoneAbbr reads a timezone abbreviation of the "MST" element of the Go reference
+// layout with the same rules as time.Parse. It's "UTC", 3 upper-case letters, 4 or 5 upper-case
+// letters that end with "T", "ChST", "MeST", "WITA", "GMT" with an optional signed hour offset like
+// "GMT+3", or a sign and the hours like "+08".
+func readReferenceZoneAbbr(value string) (string, string, error) {
+	switch {
+	case len(value) < 3:
+		return "", value, errParse
+	case strings.HasPrefix(value, "UTC"):
+		return value[0:3], value[3:], nil
+	case strings.HasPrefix(value, "ChST") || strings.HasPrefix(value, "MeST") ||
+		strings.HasPrefix(value, "WITA"):
+		return value[0:4], value[4:], nil
+	case strings.HasPrefix(value, "GMT"):
+		n := 3 + signedHoursLen(value[3:])
+		return value[0:n], value[n:], nil
+	case value[0] == '+' || value[0] == '-':
+		n := signedHoursLen(value)
+		if n == 0 {
+			return "", value, errParse
+		}
+		return value[0:n], value[n:], nil
+	}
+
+	n := 0
+	for ; n < len(value) && n < 6 && value[n] >= 'A' && value[n] <= 'Z'; n++ {
+	}
+	switch {
+	case n == 3, (n == 4 || n == 5) && value[n-1] == 'T':
+		return value[0:n], value[n:], nil
+	default:
+		return "", value, errParse
+	}
+}
+
+// signedHoursLen returns the length of the sign and the hours in the range [0, 23] at the
+// beginning of the value like "+8" or "-03", or 0 if the value does not begin with them.
+func signedHoursLen(value string) int {
+	if len(value) < 2 || (value[0] != '+' && value[0] != '-') {
+		return 0
+	}
+
+	n, hours := 1, 0
+	for ; n < len(value) && isDigit(value[n]); n++ {
+		hours = hours*10 + int(value[n]-'0')
+		if hours > 23 {
+			return 0
+		}
+	}
+	if n == 1 {
+		return 0
+	}
+
+	return n
+}
+
+// referenceZoneAbbrOffset returns the offset (in seconds east of UTC) of the abbreviation of the
+// "MST" element that is not a zone of the location, it's the hours of "GMT+3", or 0 for the other
+// abbreviations like time.Parse.
+func referenceZoneAbbrOffset(abbr string) int {
+	if len(abbr) <= 3 || !strings.HasPrefix(abbr, "GMT") {
+		return 0
+	}
+
+	hours, _ := strconv.Atoi(abbr[3:])
+	return hours * 3600
+}
+
+// referenceZoneTime returns the time of the wall clock with the abbreviation of the "MST" element
+// in the same way as time.Parse, the wall clock is a time in UTC. The "UTC" abbreviation is UTC,
+// and the time with the offset uses the location if it has the same offset and abbreviation at
+// that time. The abbreviation without the offset is looked up in the zones of the location, and
+// the unknown abbreviation is a fabricated zone that keeps the wall clock in UTC, with the hours
+// of "GMT+3" or zero offset.
+func referenceZoneTime(
+	wall time.Time,
+	abbr string,
+	offset int,
+	hasOffset bool,
+	loc *time.Location,
+) time.Time {
+	if abbr == "UTC" {
+		return wall
+	}
+
+	if hasOffset {
+		t := wall.Add(-time.Duration(offset) * time.Second)
+		if name, locOffset := t.In(loc).Zone(); name == abbr && locOffset == offset {
+			return t.In(loc)
+		}
+		return t.In(time.FixedZone(abbr, offset))
+	}
+
+	if t, ok := lookupZoneName(wall, abbr, loc); ok {
+		return t
+	}
+	return wall.In(time.FixedZone(abbr, referenceZoneAbbrOffset(abbr)))
+}
+
+// lookupZoneName returns the time of the wall clock (a time in UTC) in the location if the
+// abbreviation is a zone of the location. It tries the zone at the wall clock, and then the zones
+// at the start of January and July, which are the standard and the daylight saving time zones.
+func lookupZoneName(wall time.Time, abbr string, loc *time.Location) (time.Time, bool) {
+	year, month, day := wall.Date()
+	hour, min, sec := wall.Clock()
+	t := time.Date(year, month, day, hour, min, sec, wall.Nanosecond(), loc)
+	if name, _ := t.Zone(); name == abbr {
+		return t, true
+	}
+
+	for _, m := range []time.Month{time.January, time.July} {
+		name, offset := time.Date(year, m, 1, 0, 0, 0, 0, loc).Zone()
+		if name == abbr {
+			return wall.Add(-time.Duration(offset) * time.Second).In(loc), true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// numericZoneAbbrOffset returns the offset (in seconds east of UTC) of the numeric abbreviation
+// like "+08" or "-0330".
+func numericZoneAbbrOffset(abbr string) (int, bool) {
+	if len(abbr) == 0 || (abbr[0] != '+' && abbr[0] != '-') {
+		return 0, false
+	}
+
+	hour := int(abbr[1]-'0')*10 + int(abbr[2]-'0')
+	min := 0
+	if len(abbr) == 5 {
+		min = int(abbr[3]-'0')*10 + int(abbr[4]-'0')
+	}
+
+	offset := hour*3600 + min*60
+	if abbr[0] == '-' {
+		offset = -offset
+	}
+	return offset, true
+}
+
+// zoneLocations is the cache of the locations that are loaded by the IANA timezone names, it
+// maps the names to the *time.Location values.
+var zoneLocations sync.Map
+
+// readZoneName reads an IANA timezone name like "America/New_York" from the value, and loads the
+// location of the name. A sign that is followed by a digit ends the name, because it's the
+// beginning of an offset like "+08:00", except for the names like "Etc/GMT+8".
+func readZoneName(value string) (*time.Location, string, error) {
+	i := 0
+	for ; i < len(value); i++ {
+		c := value[i]
+		if (c == '+' || c == '-') && i+1 < len(value) && isDigit(value[i+1]) &&
+			!strings.HasSuffix(value[:i], "GMT") {
+			break
+		}
+		if !(c >= 'A' && c <= 'Z') && !(c >= 'a' && c <= 'z') && !isDigit(c) &&
+			c != '/' && c != '_' && c != '-' && c != '+' {
+			break
+		}
+	}
+	if i == 0 {
+		return nil, value, errParse
+	}
+
+	loc, err := loadZoneLocation(value[0:i])
+	if err != nil {
+		return nil, value, errParse
+	}
+
+	return loc, value[i:], nil
+}
+
+// loadZoneLocation returns the location of the IANA timezone name, and caches the loaded
+// locations to avoid reading the timezone database for every parsing.
+func loadZoneLocation(name string) (*time.Location, error) {
+	if loc, ok := zoneLocations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+	zoneLocations.Store(name, loc)
+
+	return loc, nil
+}
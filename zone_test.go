@@ -0,0 +1,151 @@
+package date
+
+import (
+	"testing"
+
+	"github.com/ghosind/go-assert"
+)
+
+func TestReadZoneAbbr(t *testing.T) {
+	a := assert.New(t)
+
+	cases := []struct {
+		value  string
+		abbr   string
+		suffix string
+		err    bool
+	}{
+		{"CST", "CST", "", false},
+		{"AEST 2024", "AEST", " 2024", false},
+		{"+08", "+08", "", false},
+		{"-0330)", "-0330", ")", false},
+		{"cst", "", "cst", true},
+		{"AB", "", "AB", true},
+		{"+8", "", "+8", true},
+		{"+083", "", "+083", true},
+	}
+
+	for _, test := range cases {
+		abbr, suffix, err := readZoneAbbr(test.value)
+		if test.err {
+			a.NotNilNow(err)
+		} else {
+			a.NilNow(err)
+		}
+		a.EqualNow(abbr, test.abbr)
+		a.EqualNow(suffix, test.suffix)
+	}
+}
+
+func TestReadReferenceZoneAbbr(t *testing.T) {
+	a := assert.New(t)
+
+	cases := []struct {
+		value  string
+		abbr   string
+		suffix string
+		err    bool
+	}{
+		{"MST 2006", "MST", " 2006", false},
+		{"UTCX", "UTC", "X", false},
+		{"AEST", "AEST", "", false},
+		{"ChST", "ChST", "", false},
+		{"WITA", "WITA", "", false},
+		{"GMT", "GMT", "", false},
+		{"GMT+3 2006", "GMT+3", " 2006", false},
+		{"GMT-10", "GMT-10", "", false},
+		{"+08", "+08", "", false},
+		{"-3)", "-3", ")", false},
+		{"ABCD", "", "ABCD", true},
+		{"ABCDEF", "", "ABCDEF", true},
+		{"-0330", "", "-0330", true},
+		{"+", "", "+", true},
+		{"cst", "", "cst", true},
+	}
+
+	for _, test := range cases {
+		abbr, suffix, err := readReferenceZoneAbbr(test.value)
+		if test.err {
+			a.NotNilNow(err, test.value)
+		} else {
+			a.NilNow(err, test.value)
+		}
+		a.EqualNow(abbr, test.abbr, test.value)
+		a.EqualNow(suffix, test.suffix, test.value)
+	}
+
+	a.EqualNow(referenceZoneAbbrOffset("GMT+3"), 3*3600)
+	a.EqualNow(referenceZoneAbbrOffset("GMT-10"), -10*3600)
+	a.EqualNow(referenceZoneAbbrOffset("XYZ"), 0)
+}
+
+func TestNumericZoneAbbrOffset(t *testing.T) {
+	a := assert.New(t)
+
+	offset, ok := numericZoneAbbrOffset("+08")
+	a.TrueNow(ok)
+	a.EqualNow(offset, 8*3600)
+
+	offset, ok = numericZoneAbbrOffset("-0330")
+	a.TrueNow(ok)
+	a.EqualNow(offset, -3*3600-30*60)
+
+	_, ok = numericZoneAbbrOffset("CST")
+	a.NotTrueNow(ok)
+}
+
+func TestReadZoneName(t *testing.T) {
+	a := assert.New(t)
+
+	cases := []struct {
+		value  string
+		name   string
+		suffix string
+		err    bool
+	}{
+		{"America/New_York", "America/New_York", "", false},
+		{"America/New_York+08:00", "America/New_York", "+08:00", false},
+		{"Asia/Shanghai-0500 x", "Asia/Shanghai", "-0500 x", false},
+		{"America/Port-au-Prince", "America/Port-au-Prince", "", false},
+		{"Etc/GMT+8", "Etc/GMT+8", "", false},
+		{"Etc/GMT-14)", "Etc/GMT-14", ")", false},
+		{"Unknown/Zone", "", "Unknown/Zone", true},
+		{"+08:00", "", "+08:00", true},
+	}
+
+	for _, test := range cases {
+		loc, suffix, err := readZoneName(test.value)
+		if test.err {
+			a.NotNilNow(err, test.value)
+			a.NilNow(loc, test.value)
+		} else {
+			a.NilNow(err, test.value)
+			a.EqualNow(loc.String(), test.name, test.value)
+		}
+		a.EqualNow(suffix, test.suffix, test.value)
+	}
+
+	loc, _, err := readZoneName("Europe/Paris")
+	a.NilNow(err)
+	cached, ok := zoneLocations.Load("Europe/Paris")
+	a.TrueNow(ok)
+	a.EqualNow(cached, loc)
+}
+
+// RestoreZoneAbbreviations restores the timezone abbreviation table when the test completes, it's
+// used by the external tests that register the abbreviations.
+func RestoreZoneAbbreviations(t *testing.T) {
+	zoneAbbrsMutex.RLock()
+	abbrs := make(map[string]int, len(zoneAbbrs))
+	for abbr, offset := range zoneAbbrs {
+		abbrs[abbr] = offset
+	}
+	zoneAbbrsMutex.RUnlock()
+
+	t.Cleanup(func() {
+		zoneAbbrsMutex.Lock()
+		defer zoneAbbrsMutex.Unlock()
+
+		zoneAbbrs = abbrs
+	})
+}